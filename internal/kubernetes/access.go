@@ -0,0 +1,160 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AccessCheck describes a single permission to verify
+type AccessCheck struct {
+	Verb        string // Verb (e.g., "get", "create", "*")
+	Group       string // API group, empty for the core group
+	Resource    string // Resource (e.g., "deployments")
+	Subresource string // Subresource (e.g., "status"), optional
+	Name        string // Resource name, optional
+	Namespace   string // Namespace, empty for cluster-wide
+}
+
+// AccessConfig holds the configuration for waiting on RBAC permissions
+type AccessConfig struct {
+	Checks         []AccessCheck // Permissions that must all be allowed
+	User           string        // User to check, empty for the current identity
+	Groups         []string      // Groups of the user to check
+	ServiceAccount string        // Service account to check as "namespace/name"
+	Timeout        time.Duration // Maximum wait time
+	CheckInterval  time.Duration // Interval between checks
+}
+
+// AccessChecker waits for permissions to be granted using access reviews
+type AccessChecker struct {
+	Client *Client
+	Config *AccessConfig
+}
+
+// WaitForAccess waits until all permissions are allowed
+func (c *AccessChecker) WaitForAccess(ctx context.Context) (*WaitResult, error) {
+	return poll(ctx, c.Config.Timeout, c.Config.CheckInterval, "access allowed", c.CheckAccess)
+}
+
+// CheckAccess performs a single access review of all permissions
+func (c *AccessChecker) CheckAccess(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	user, groups, err := c.subject()
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      err.Error(),
+		}, err
+	}
+
+	denied := []string{}
+	for _, check := range c.Config.Checks {
+		allowed, reason, err := c.review(ctx, check, user, groups)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to review access for %s: %s", describeAccessCheck(check), err),
+			}, err
+		}
+		if !allowed {
+			description := describeAccessCheck(check)
+			if reason != "" {
+				description = fmt.Sprintf("%s (%s)", description, reason)
+			}
+			denied = append(denied, description)
+		}
+	}
+
+	total := len(c.Config.Checks)
+	if len(denied) > 0 {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("%d/%d permissions allowed, denied: %s", total-len(denied), total, strings.Join(denied, "; ")),
+		}, nil
+	}
+
+	return &WaitResult{
+		ConditionMet: true,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("%d/%d permissions allowed", total, total),
+	}, nil
+}
+
+// subject returns the user and groups to review, empty for the current identity
+func (c *AccessChecker) subject() (string, []string, error) {
+	if c.Config.ServiceAccount == "" {
+		return c.Config.User, c.Config.Groups, nil
+	}
+
+	parts := strings.SplitN(c.Config.ServiceAccount, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", nil, fmt.Errorf("service account must be in format 'namespace/name', got %q", c.Config.ServiceAccount)
+	}
+
+	user := fmt.Sprintf("system:serviceaccount:%s:%s", parts[0], parts[1])
+	groups := []string{"system:serviceaccounts", "system:serviceaccounts:" + parts[0], "system:authenticated"}
+	return user, groups, nil
+}
+
+// review runs a SelfSubjectAccessReview for the current identity, or a
+// SubjectAccessReview when a user is given
+func (c *AccessChecker) review(ctx context.Context, check AccessCheck, user string, groups []string) (bool, string, error) {
+	attributes := &authorizationv1.ResourceAttributes{
+		Namespace:   check.Namespace,
+		Verb:        check.Verb,
+		Group:       check.Group,
+		Resource:    check.Resource,
+		Subresource: check.Subresource,
+		Name:        check.Name,
+	}
+
+	if user == "" && len(groups) == 0 {
+		review, err := c.Client.Clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{ResourceAttributes: attributes},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return false, "", err
+		}
+		return review.Status.Allowed, review.Status.Reason, nil
+	}
+
+	review, err := c.Client.Clientset.AuthorizationV1().SubjectAccessReviews().Create(ctx, &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: attributes,
+			User:               user,
+			Groups:             groups,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return false, "", err
+	}
+	return review.Status.Allowed, review.Status.Reason, nil
+}
+
+// describeAccessCheck formats a permission like "create deployments.apps in production"
+func describeAccessCheck(check AccessCheck) string {
+	resource := check.Resource
+	if check.Group != "" {
+		resource = resource + "." + check.Group
+	}
+	if check.Subresource != "" {
+		resource = resource + "/" + check.Subresource
+	}
+	if check.Name != "" {
+		resource = resource + "/" + check.Name
+	}
+
+	if check.Namespace == "" {
+		return fmt.Sprintf("%s %s", check.Verb, resource)
+	}
+	return fmt.Sprintf("%s %s in %s", check.Verb, resource, check.Namespace)
+}
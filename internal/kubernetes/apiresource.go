@@ -0,0 +1,219 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	customResourceDefinitionsGVR = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+	apiServicesGVR               = schema.GroupVersionResource{Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices"}
+)
+
+// APIResourceConfig holds the configuration for waiting on an API resource to be served
+type APIResourceConfig struct {
+	APIVersion    string        // Group/version (e.g., "cert-manager.io/v1")
+	Kind          string        // Kind to look for (e.g., "Certificate")
+	Resource      string        // Plural resource name to look for (e.g., "certificates")
+	Timeout       time.Duration // Maximum wait time
+	CheckInterval time.Duration // Interval between checks
+}
+
+// APIResourceResult holds the result of an API resource wait
+type APIResourceResult struct {
+	WaitResult
+	Resource   string // Plural resource name served by discovery
+	Namespaced bool   // Whether the resource is namespaced
+}
+
+// APIResourceChecker waits for a group/version/kind to be served by the API server
+type APIResourceChecker struct {
+	Client *Client
+	Config *APIResourceConfig
+
+	resource *metav1.APIResource
+}
+
+// WaitForAPIResource waits until the API resource is served
+func (c *APIResourceChecker) WaitForAPIResource(ctx context.Context) (*APIResourceResult, error) {
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, c.describe(), c.CheckAPIResource)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	apiResourceResult := &APIResourceResult{WaitResult: *result}
+	if c.resource != nil {
+		apiResourceResult.Resource = c.resource.Name
+		apiResourceResult.Namespaced = c.resource.Namespaced
+	}
+	return apiResourceResult, err
+}
+
+// CheckAPIResource performs a single check of the API resource availability
+func (c *APIResourceChecker) CheckAPIResource(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	groupVersion, err := schema.ParseGroupVersion(c.Config.APIVersion)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Invalid api_version %q: %s", c.Config.APIVersion, err),
+		}, err
+	}
+
+	// An aggregated API that is not served yet makes discovery of its group
+	// version fail, so check the APIService first for a better message
+	if groupVersion.Group != "" {
+		available, message, err := c.checkAPIService(ctx, groupVersion)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to get APIService: %s", err),
+			}, err
+		}
+		if !available {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      message,
+			}, nil
+		}
+	}
+
+	resourceList, err := c.Client.Clientset.Discovery().ServerResourcesForGroupVersion(groupVersion.String())
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("API group version %s is not served yet", groupVersion),
+			}, nil
+		}
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to discover resources for %s: %s", groupVersion, err),
+		}, err
+	}
+
+	// Without a kind or resource the group version being served is enough
+	if c.Config.Kind == "" && c.Config.Resource == "" {
+		return &WaitResult{
+			ConditionMet: true,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("API group version %s is served", groupVersion),
+		}, nil
+	}
+
+	c.resource = nil
+	for i := range resourceList.APIResources {
+		apiResource := resourceList.APIResources[i]
+		// Skip subresources such as "certificates/status"
+		if strings.Contains(apiResource.Name, "/") {
+			continue
+		}
+		if c.Config.Kind != "" && apiResource.Kind != c.Config.Kind {
+			continue
+		}
+		if c.Config.Resource != "" && apiResource.Name != c.Config.Resource {
+			continue
+		}
+		c.resource = &apiResource
+		break
+	}
+
+	if c.resource == nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("%s is not served yet", c.describe()),
+		}, nil
+	}
+
+	if groupVersion.Group != "" {
+		established, message, err := c.checkCustomResourceDefinition(ctx, groupVersion.Group, c.resource.Name)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to get CustomResourceDefinition: %s", err),
+			}, err
+		}
+		if !established {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      message,
+			}, nil
+		}
+	}
+
+	return &WaitResult{
+		ConditionMet: true,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("%s is served as %s", c.describe(), c.resource.Name),
+	}, nil
+}
+
+// checkAPIService checks the APIService registered for a group version is
+// Available. Group versions without an APIService, or an identity without
+// permission to read them, are treated as available and left to discovery.
+func (c *APIResourceChecker) checkAPIService(ctx context.Context, groupVersion schema.GroupVersion) (bool, string, error) {
+	name := groupVersion.Version + "." + groupVersion.Group
+	apiService, err := c.Client.Dynamic.Resource(apiServicesGVR).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+			return true, "", nil
+		}
+		return false, "", err
+	}
+
+	condition, found := findUnstructuredCondition(apiService, "Available")
+	if !found || condition.Status != "True" {
+		return false, fmt.Sprintf("APIService %s is not Available: %s", name, condition.Message), nil
+	}
+
+	return true, "", nil
+}
+
+// checkCustomResourceDefinition checks the CRD backing a resource, if any, is
+// Established and has its names accepted
+func (c *APIResourceChecker) checkCustomResourceDefinition(ctx context.Context, group, resource string) (bool, string, error) {
+	name := resource + "." + group
+	crd, err := c.Client.Dynamic.Resource(customResourceDefinitionsGVR).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsForbidden(err) {
+			return true, "", nil
+		}
+		return false, "", err
+	}
+
+	for _, conditionType := range []string{"NamesAccepted", "Established"} {
+		condition, found := findUnstructuredCondition(crd, conditionType)
+		if !found || condition.Status != "True" {
+			return false, fmt.Sprintf("CustomResourceDefinition %s is not %s: %s", name, conditionType, condition.Message), nil
+		}
+	}
+
+	return true, "", nil
+}
+
+// describe returns a human readable description of the awaited resource
+func (c *APIResourceChecker) describe() string {
+	switch {
+	case c.Config.Kind != "":
+		return fmt.Sprintf("%s, Kind=%s", c.Config.APIVersion, c.Config.Kind)
+	case c.Config.Resource != "":
+		return fmt.Sprintf("%s, Resource=%s", c.Config.APIVersion, c.Config.Resource)
+	default:
+		return c.Config.APIVersion
+	}
+}
@@ -7,6 +7,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -16,6 +19,7 @@ import (
 // Client wraps the Kubernetes clientset with additional functionality
 type Client struct {
 	Clientset *kubernetes.Clientset
+	Dynamic   dynamic.Interface
 	Config    *rest.Config
 }
 
@@ -99,8 +103,84 @@ func NewClient(ctx context.Context, config *ClientConfig) (*Client, error) {
 		return nil, fmt.Errorf("failed to create Kubernetes clientset: %w", err)
 	}
 
+	// Create the dynamic client for resources without typed clients
+	dynamicClient, err := dynamic.NewForConfig(kubeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Kubernetes dynamic client: %w", err)
+	}
+
 	return &Client{
 		Clientset: clientset,
+		Dynamic:   dynamicClient,
 		Config:    kubeConfig,
 	}, nil
 }
+
+// resourceForKind resolves a group/version/kind to its resource using discovery,
+// returning whether the resource is namespaced
+func (c *Client) resourceForKind(gvk schema.GroupVersionKind) (schema.GroupVersionResource, bool, error) {
+	resourceList, err := c.Clientset.Discovery().ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if err != nil {
+		return schema.GroupVersionResource{}, false, err
+	}
+
+	for _, apiResource := range resourceList.APIResources {
+		// Skip subresources such as "deployments/status"
+		if apiResource.Kind == gvk.Kind && !strings.Contains(apiResource.Name, "/") {
+			return gvk.GroupVersion().WithResource(apiResource.Name), apiResource.Namespaced, nil
+		}
+	}
+
+	return schema.GroupVersionResource{}, false, fmt.Errorf("no resource found for %s", gvk)
+}
+
+// resolvedResource is a resource type resolved through discovery
+type resolvedResource struct {
+	GroupVersionResource schema.GroupVersionResource
+	Kind                 string
+	Namespaced           bool
+}
+
+// resolveResource resolves a resource type given as a plural, singular, kind
+// or short name, optionally qualified with its group (e.g., "certificates",
+// "Certificate" or "certificates.cert-manager.io"), to its preferred version
+func (c *Client) resolveResource(resource string) (*resolvedResource, error) {
+	name, group, qualified := strings.Cut(strings.ToLower(resource), ".")
+
+	// Groups that fail discovery, like an unavailable aggregated API, still leave the others usable
+	resourceLists, err := c.Clientset.Discovery().ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+
+	for _, resourceList := range resourceLists {
+		groupVersion, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		if qualified && groupVersion.Group != group {
+			continue
+		}
+
+		for _, apiResource := range resourceList.APIResources {
+			// Skip subresources such as "deployments/status"
+			if strings.Contains(apiResource.Name, "/") {
+				continue
+			}
+
+			matches := apiResource.Name == name || apiResource.SingularName == name || strings.ToLower(apiResource.Kind) == name
+			for _, shortName := range apiResource.ShortNames {
+				matches = matches || shortName == name
+			}
+			if matches {
+				return &resolvedResource{
+					GroupVersionResource: groupVersion.WithResource(apiResource.Name),
+					Kind:                 apiResource.Kind,
+					Namespaced:           apiResource.Namespaced,
+				}, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("resource type %q is not served by the cluster", resource)
+}
@@ -0,0 +1,205 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// ClusterHealthConfig holds the configuration for waiting on API server health
+type ClusterHealthConfig struct {
+	Readyz        bool          // Require /readyz to report ready
+	Livez         bool          // Require /livez to report live
+	Verbose       bool          // Request verbose output and report individual checks
+	Checks        []string      // Individual /readyz checks that must pass (e.g., "etcd", "informer-sync")
+	MinVersion    string        // Minimum server version (e.g., "1.28"), empty to skip
+	Timeout       time.Duration // Maximum wait time
+	CheckInterval time.Duration // Interval between checks
+}
+
+// ClusterHealthResult holds the result of an API server health wait
+type ClusterHealthResult struct {
+	WaitResult
+	ServerVersion string // GitVersion reported by discovery
+	Platform      string // Platform reported by discovery (e.g., "linux/amd64")
+}
+
+// ClusterHealthChecker waits for the API server health endpoints to report healthy
+type ClusterHealthChecker struct {
+	Client *Client
+	Config *ClusterHealthConfig
+
+	serverVersion string
+	platform      string
+}
+
+// WaitForHealthy waits until the API server reports healthy
+func (c *ClusterHealthChecker) WaitForHealthy(ctx context.Context) (*ClusterHealthResult, error) {
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, "cluster healthy", c.CheckHealth)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	return &ClusterHealthResult{
+		WaitResult:    *result,
+		ServerVersion: c.serverVersion,
+		Platform:      c.platform,
+	}, err
+}
+
+// CheckHealth performs a single check of the API server health endpoints
+func (c *ClusterHealthChecker) CheckHealth(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	failures := []string{}
+
+	if c.Config.Livez {
+		healthy, failed, err := c.checkHealthEndpoint(ctx, "/livez", nil)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to query /livez: %s", err),
+			}, err
+		}
+		if !healthy {
+			failures = append(failures, describeFailedChecks("livez", failed))
+		}
+	}
+
+	if c.Config.Readyz || len(c.Config.Checks) > 0 {
+		healthy, failed, err := c.checkHealthEndpoint(ctx, "/readyz", c.Config.Checks)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to query /readyz: %s", err),
+			}, err
+		}
+		if !healthy {
+			failures = append(failures, describeFailedChecks("readyz", failed))
+		}
+	}
+
+	serverVersion, err := c.Client.Clientset.Discovery().ServerVersion()
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to get server version: %s", err),
+		}, err
+	}
+	c.serverVersion = serverVersion.GitVersion
+	c.platform = serverVersion.Platform
+
+	if c.Config.MinVersion != "" {
+		minVersion, err := version.ParseGeneric(c.Config.MinVersion)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Invalid minimum version %q: %s", c.Config.MinVersion, err),
+			}, err
+		}
+
+		currentVersion, err := version.ParseGeneric(serverVersion.GitVersion)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Unable to parse server version %q: %s", serverVersion.GitVersion, err),
+			}, err
+		}
+
+		if !currentVersion.AtLeast(minVersion) {
+			failures = append(failures, fmt.Sprintf("server version %s is older than %s", serverVersion.GitVersion, c.Config.MinVersion))
+		}
+	}
+
+	if len(failures) > 0 {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("API server not healthy: %s", strings.Join(failures, "; ")),
+		}, nil
+	}
+
+	return &WaitResult{
+		ConditionMet: true,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("API server %s is healthy", serverVersion.GitVersion),
+	}, nil
+}
+
+// checkHealthEndpoint queries a health endpoint such as /readyz. If checks is
+// not empty only those individual checks must pass, otherwise the endpoint as
+// a whole must report healthy. It returns the names of failed checks when the
+// verbose output is available.
+func (c *ClusterHealthChecker) checkHealthEndpoint(ctx context.Context, path string, checks []string) (bool, []string, error) {
+	request := c.Client.Clientset.Discovery().RESTClient().Get().AbsPath(path)
+	if c.Config.Verbose || len(checks) > 0 {
+		request = request.Param("verbose", "")
+	}
+
+	var statusCode int
+	body, err := request.Do(ctx).StatusCode(&statusCode).Raw()
+
+	// An unhealthy endpoint answers 500 with the list of checks in the body
+	if err != nil && statusCode != http.StatusInternalServerError {
+		return false, nil, err
+	}
+
+	passed, failed := parseHealthChecks(string(body))
+
+	if len(checks) > 0 {
+		missing := []string{}
+		for _, check := range checks {
+			if !passed[check] {
+				missing = append(missing, check)
+			}
+		}
+		return len(missing) == 0, missing, nil
+	}
+
+	return statusCode == http.StatusOK, failed, nil
+}
+
+// parseHealthChecks parses verbose health output lines such as "[+]etcd ok"
+// and "[-]informer-sync failed: reason withheld"
+func parseHealthChecks(body string) (map[string]bool, []string) {
+	passed := map[string]bool{}
+	failed := []string{}
+
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) < 3 || line[0] != '[' || line[2] != ']' {
+			continue
+		}
+
+		name := strings.Fields(line[3:])
+		if len(name) == 0 {
+			continue
+		}
+
+		switch line[1] {
+		case '+':
+			passed[name[0]] = true
+		case '-':
+			failed = append(failed, name[0])
+		}
+	}
+
+	return passed, failed
+}
+
+// describeFailedChecks formats the failed checks of a health endpoint
+func describeFailedChecks(endpoint string, failed []string) string {
+	if len(failed) == 0 {
+		return fmt.Sprintf("%s not ok", endpoint)
+	}
+	return fmt.Sprintf("%s checks failing: %s", endpoint, strings.Join(failed, ", "))
+}
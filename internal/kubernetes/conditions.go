@@ -2,12 +2,22 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net"
+	"strconv"
 	"strings"
 	"time"
 
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 )
 
@@ -22,6 +32,12 @@ type WaitConfig struct {
 	All           bool          // Wait for all matching resources
 	Timeout       time.Duration // Maximum wait time
 	CheckInterval time.Duration // Interval between checks
+	MaxRestarts   *int32        // Fail if a container restarts more than this many times during the wait (pods only)
+	Trigger       bool          // Create a Job from each matching CronJob's template on the first check (cronjobs only)
+
+	Images              map[string]string // Expected image reference by container name (pods and workloads)
+	CompareImageDigests bool              // Also compare the imageID digests of running containers
+	ChildrenOf          string            // Owner whose current pods are checked, e.g. "deployment/my-app" (pods only)
 }
 
 // WaitResult holds the result of a wait operation
@@ -29,25 +45,52 @@ type WaitResult struct {
 	ConditionMet bool      // Whether the condition was met
 	LastChecked  time.Time // When the condition was last checked
 	Message      string    // Status message
+	IPs          []string  // IP addresses assigned to the matching resources
+	Hostnames    []string  // Hostnames assigned to the matching resources
 }
 
 // ConditionChecker provides functionality to wait for Kubernetes resource conditions
 type ConditionChecker struct {
 	Client *Client
 	Config *WaitConfig
+
+	// resourceConditionCheckers maps resource types to their condition checking functions
+	resourceConditionCheckers map[string]conditionCheckFunc
+
+	// restartBaseline holds container restart counts seen at the first check, keyed by "pod/container"
+	restartBaseline map[string]int32
+
+	// waitStarted is the time of the first check
+	waitStarted time.Time
+
+	// triggeredJobs holds the names of the Jobs created from CronJobs, keyed by CronJob name
+	triggeredJobs map[string]string
+
+	// triggerID labels the Jobs created from CronJobs by this wait
+	triggerID string
 }
 
 // conditionCheckFunc defines the signature for condition checking functions
 type conditionCheckFunc func(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error)
 
-// resourceConditionCheckers maps resource types to their condition checking functions
-var resourceConditionCheckers = map[string]conditionCheckFunc{}
-
-// WaitForCondition waits for the specified condition to be met
+// WaitForCondition waits for the specified condition to be met.
+// Transient API errors (connection refused, 429, 5xx, etcd leader changes, ...)
+// are retried with backoff until the timeout; other errors fail immediately.
 func (c *ConditionChecker) WaitForCondition(ctx context.Context) (*WaitResult, error) {
-	deadline := time.Now().Add(c.Config.Timeout)
-	ticker := time.NewTicker(c.Config.CheckInterval)
-	defer ticker.Stop()
+	return poll(ctx, c.Config.Timeout, c.Config.CheckInterval, c.Config.Condition, c.CheckCondition)
+}
+
+// poll calls check every interval until it reports the condition as met, the
+// timeout expires or check returns an error that is not transient
+func poll(ctx context.Context, timeout, interval time.Duration, description string, check func(context.Context) (*WaitResult, error)) (*WaitResult, error) {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	next := time.NewTimer(interval)
+	defer next.Stop()
+
+	var lastErr error
+	var lastResult *WaitResult
+	var retryDelay time.Duration
 
 	for {
 		select {
@@ -58,17 +101,41 @@ func (c *ConditionChecker) WaitForCondition(ctx context.Context) (*WaitResult, e
 				Message:      "Context cancelled",
 			}, ctx.Err()
 
-		case <-time.After(time.Until(deadline)):
+		case <-deadline.C:
+			if lastErr != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  time.Now(),
+					Message:      fmt.Sprintf("Timeout after %v, last error: %s", timeout, lastErr),
+				}, fmt.Errorf("timeout waiting for condition %s: %w", description, lastErr)
+			}
+			// Report the state seen by the last check to explain what was still missing
+			if lastResult != nil && lastResult.Message != "" {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  time.Now(),
+					Message:      fmt.Sprintf("Timeout after %v: %s", timeout, lastResult.Message),
+				}, fmt.Errorf("timeout waiting for condition %s: %s", description, lastResult.Message)
+			}
 			return &WaitResult{
 				ConditionMet: false,
 				LastChecked:  time.Now(),
-				Message:      fmt.Sprintf("Timeout after %v", c.Config.Timeout),
-			}, fmt.Errorf("timeout waiting for condition %s", c.Config.Condition)
+				Message:      fmt.Sprintf("Timeout after %v", timeout),
+			}, fmt.Errorf("timeout waiting for condition %s", description)
 
-		case <-ticker.C:
-			result, err := c.CheckCondition(ctx)
+		case <-next.C:
+			result, err := check(ctx)
 			if err != nil {
-				return result, err
+				retryable, suggestedDelay := isRetryableError(err)
+				if !retryable {
+					return result, err
+				}
+
+				// The API server may still be booting, keep polling with backoff
+				lastErr = err
+				retryDelay = nextRetryDelay(retryDelay, suggestedDelay)
+				next.Reset(retryDelay)
+				continue
 			}
 
 			if result.ConditionMet {
@@ -76,6 +143,10 @@ func (c *ConditionChecker) WaitForCondition(ctx context.Context) (*WaitResult, e
 			}
 
 			// Continue waiting if condition not met
+			lastErr = nil
+			lastResult = result
+			retryDelay = 0
+			next.Reset(interval)
 		}
 	}
 }
@@ -85,10 +156,14 @@ func (c *ConditionChecker) CheckCondition(ctx context.Context) (*WaitResult, err
 	now := time.Now()
 
 	// Initialize the resource condition checkers map if empty
-	if len(resourceConditionCheckers) == 0 {
+	if len(c.resourceConditionCheckers) == 0 {
 		c.initResourceCheckers()
 	}
 
+	if c.waitStarted.IsZero() {
+		c.waitStarted = now
+	}
+
 	// Parse the condition
 	conditionType, conditionValue, err := c.parseCondition()
 	if err != nil {
@@ -99,16 +174,28 @@ func (c *ConditionChecker) CheckCondition(ctx context.Context) (*WaitResult, err
 		}, err
 	}
 
-	// Get the appropriate condition checker function
+	if err := c.validateOptions(conditionType, conditionValue); err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      err.Error(),
+		}, err
+	}
+
 	resourceType := strings.ToLower(c.Config.Resource)
-	if checkFunc, exists := resourceConditionCheckers[resourceType]; exists {
+	if usesGenericChecker(conditionType, resourceType) {
+		return c.checkGenericCondition(ctx, conditionType, conditionValue)
+	}
+
+	// Get the appropriate condition checker function
+	if checkFunc, exists := c.resourceConditionCheckers[resourceType]; exists {
 		return checkFunc(ctx, conditionType, conditionValue)
 	}
 
 	// Handle plural forms by trying to remove 's'
 	if strings.HasSuffix(resourceType, "s") {
 		singularType := strings.TrimSuffix(resourceType, "s")
-		if checkFunc, exists := resourceConditionCheckers[singularType]; exists {
+		if checkFunc, exists := c.resourceConditionCheckers[singularType]; exists {
 			return checkFunc(ctx, conditionType, conditionValue)
 		}
 	}
@@ -117,9 +204,52 @@ func (c *ConditionChecker) CheckCondition(ctx context.Context) (*WaitResult, err
 	return c.checkGenericCondition(ctx, conditionType, conditionValue)
 }
 
+// usesGenericChecker reports whether a condition goes through the generic
+// checker even for resources with a typed checker
+func usesGenericChecker(conditionType, resourceType string) bool {
+	switch conditionType {
+	case "current", "healthy", "jsonpath":
+		// The kstatus computation, the built-in health rules and JSONPath
+		// expressions work on any resource, including the typed ones
+		return true
+	case "delete":
+		// Deletion only needs the objects to be gone, except for namespaces,
+		// whose checker reports what is blocking their termination
+		return !isNamespaceResource(resourceType)
+	}
+	return false
+}
+
+// validateOptions rejects resource options the condition would not wait for
+func (c *ConditionChecker) validateOptions(conditionType, conditionValue string) error {
+	if c.Config.Trigger && (conditionType != "job" || !strings.EqualFold(conditionValue, "completed")) {
+		return fmt.Errorf("trigger can only be used with job=completed, %s would be met without waiting for the triggered job", c.Config.Condition)
+	}
+
+	// The generic checker looks at every matching object and knows nothing
+	// about the options of the typed resources
+	if usesGenericChecker(conditionType, strings.ToLower(c.Config.Resource)) {
+		options := []string{}
+		if c.Config.ChildrenOf != "" {
+			options = append(options, "children_of")
+		}
+		if c.Config.MaxRestarts != nil {
+			options = append(options, "max_restarts")
+		}
+		if len(c.Config.Images) > 0 {
+			options = append(options, "images")
+		}
+		if len(options) > 0 {
+			return fmt.Errorf("%s cannot be used with %s conditions", strings.Join(options, " and "), conditionType)
+		}
+	}
+
+	return nil
+}
+
 // initResourceCheckers initializes the map of resource condition checkers
 func (c *ConditionChecker) initResourceCheckers() {
-	resourceConditionCheckers = map[string]conditionCheckFunc{
+	c.resourceConditionCheckers = map[string]conditionCheckFunc{
 		"node":         c.checkNodeCondition,
 		"nodes":        c.checkNodeCondition,
 		"pod":          c.checkPodCondition,
@@ -137,11 +267,52 @@ func (c *ConditionChecker) initResourceCheckers() {
 		"cronjob":      c.checkCronJobCondition,
 		"cronjobs":     c.checkCronJobCondition,
 		"ingress":      c.checkIngressCondition,
+
+		"persistentvolumeclaim":  c.checkPersistentVolumeClaimCondition,
+		"persistentvolumeclaims": c.checkPersistentVolumeClaimCondition,
+		"pvc":                    c.checkPersistentVolumeClaimCondition,
+		"pvcs":                   c.checkPersistentVolumeClaimCondition,
+		"persistentvolume":       c.checkPersistentVolumeCondition,
+		"persistentvolumes":      c.checkPersistentVolumeCondition,
+		"pv":                     c.checkPersistentVolumeCondition,
+		"pvs":                    c.checkPersistentVolumeCondition,
+
+		"namespace":  c.checkNamespaceCondition,
+		"namespaces": c.checkNamespaceCondition,
+		"ns":         c.checkNamespaceCondition,
+
+		"gateway":    c.checkGatewayCondition,
+		"gateways":   c.checkGatewayCondition,
+		"httproute":  c.checkRouteCondition("httproutes"),
+		"httproutes": c.checkRouteCondition("httproutes"),
+		"grpcroute":  c.checkRouteCondition("grpcroutes"),
+		"grpcroutes": c.checkRouteCondition("grpcroutes"),
+		"tlsroute":   c.checkRouteCondition("tlsroutes"),
+		"tlsroutes":  c.checkRouteCondition("tlsroutes"),
+		"tcproute":   c.checkRouteCondition("tcproutes"),
+		"tcproutes":  c.checkRouteCondition("tcproutes"),
+		"udproute":   c.checkRouteCondition("udproutes"),
+		"udproutes":  c.checkRouteCondition("udproutes"),
 	}
 }
 
 // parseCondition parses condition strings like "condition=Ready" or "jsonpath=.status.phase==Running"
 func (c *ConditionChecker) parseCondition() (string, string, error) {
+	// "delete" waits for the matching resources to be gone, like kubectl wait --for=delete
+	if strings.EqualFold(strings.TrimSpace(c.Config.Condition), "delete") {
+		return "delete", "", nil
+	}
+
+	// "healthy" applies the built-in health rule for the resource kind
+	if strings.EqualFold(strings.TrimSpace(c.Config.Condition), "healthy") {
+		return "healthy", "", nil
+	}
+
+	// "current" waits for the kstatus status of the resources to be Current
+	if strings.EqualFold(strings.TrimSpace(c.Config.Condition), "current") {
+		return "current", "", nil
+	}
+
 	parts := strings.SplitN(c.Config.Condition, "=", 2)
 	if len(parts) != 2 {
 		return "", "", fmt.Errorf("condition must be in format 'type=value'")
@@ -204,6 +375,18 @@ func (c *ConditionChecker) checkNodeCondition(ctx context.Context, conditionType
 					break
 				}
 			}
+		} else {
+			met, err := nodeMeetsCondition(&node, conditionType, conditionValue)
+			if err != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      fmt.Sprintf("Invalid node condition: %s", err),
+				}, err
+			}
+			if met {
+				readyNodes++
+			}
 		}
 	}
 
@@ -237,13 +420,34 @@ func (c *ConditionChecker) checkPodCondition(ctx context.Context, conditionType,
 		listOptions.FieldSelector = c.Config.FieldSelector
 	}
 
-	podList, err := c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).List(ctx, listOptions)
-	if err != nil {
-		return &WaitResult{
-			ConditionMet: false,
-			LastChecked:  now,
-			Message:      fmt.Sprintf("Failed to list pods: %s", err),
-		}, err
+	podList := &corev1.PodList{}
+	var err error
+	if c.Config.ChildrenOf != "" {
+		podList.Items, err = c.listChildPods(ctx)
+		if apierrors.IsNotFound(err) {
+			// The owner may be created later in the same apply
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("owner %s not found yet", c.Config.ChildrenOf),
+			}, nil
+		}
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to list pods of %s: %s", c.Config.ChildrenOf, err),
+			}, err
+		}
+	} else {
+		podList, err = c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).List(ctx, listOptions)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to list pods: %s", err),
+			}, err
+		}
 	}
 
 	if c.Config.Name != "" {
@@ -265,6 +469,16 @@ func (c *ConditionChecker) checkPodCondition(ctx context.Context, conditionType,
 		}, nil
 	}
 
+	if c.Config.MaxRestarts != nil {
+		if err := c.checkRestarts(podList.Items); err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      err.Error(),
+			}, err
+		}
+	}
+
 	readyPods := 0
 	totalPods := len(podList.Items)
 
@@ -280,6 +494,20 @@ func (c *ConditionChecker) checkPodCondition(ctx context.Context, conditionType,
 			if string(pod.Status.Phase) == conditionValue {
 				readyPods++
 			}
+		} else if conditionType == "container" {
+			// Readiness of a single container, e.g. a sidecar like istio-proxy
+			if status, found := findContainerStatus(&pod, conditionValue); found && status.Ready {
+				readyPods++
+			}
+		} else if conditionType == "started" {
+			// Startup probe of a single container has succeeded
+			if status, found := findContainerStatus(&pod, conditionValue); found && status.Started != nil && *status.Started {
+				readyPods++
+			}
+		} else if conditionType == "init" {
+			if initContainersCompleted(&pod) {
+				readyPods++
+			}
 		}
 	}
 
@@ -294,6 +522,14 @@ func (c *ConditionChecker) checkPodCondition(ctx context.Context, conditionType,
 		message = fmt.Sprintf("%d/%d pods meet condition %s", readyPods, totalPods, c.Config.Condition)
 	}
 
+	if len(c.Config.Images) > 0 {
+		// Every matching pod must run the expected images, regardless of all
+		if wrongImages := c.podsOnWrongImage(podList.Items); len(wrongImages) > 0 {
+			conditionMet = false
+			message = fmt.Sprintf("%s, pods on wrong image: %s", message, strings.Join(wrongImages, "; "))
+		}
+	}
+
 	return &WaitResult{
 		ConditionMet: conditionMet,
 		LastChecked:  now,
@@ -301,6 +537,80 @@ func (c *ConditionChecker) checkPodCondition(ctx context.Context, conditionType,
 	}, nil
 }
 
+// checkRestarts fails when a container has restarted more than MaxRestarts
+// times since the first check. Restarts before the wait started are ignored.
+func (c *ConditionChecker) checkRestarts(pods []corev1.Pod) error {
+	firstCheck := c.restartBaseline == nil
+	if firstCheck {
+		c.restartBaseline = map[string]int32{}
+	}
+
+	for _, pod := range pods {
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			key := pod.Name + "/" + status.Name
+			if firstCheck {
+				c.restartBaseline[key] = status.RestartCount
+				continue
+			}
+
+			// Containers first seen after the wait started count all of their restarts
+			restarts := status.RestartCount - c.restartBaseline[key]
+			if restarts > *c.Config.MaxRestarts {
+				return fmt.Errorf("container %s of pod %s restarted %d times during the wait, more than the maximum of %d",
+					status.Name, pod.Name, restarts, *c.Config.MaxRestarts)
+			}
+		}
+	}
+
+	return nil
+}
+
+// findContainerStatus returns the status of the named container or init container of a pod
+func findContainerStatus(pod *corev1.Pod, name string) (corev1.ContainerStatus, bool) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == name {
+			return status, true
+		}
+	}
+	// Sidecars are restartable init containers
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name == name {
+			return status, true
+		}
+	}
+	return corev1.ContainerStatus{}, false
+}
+
+// initContainersCompleted reports whether every init container of a pod has
+// completed successfully, or started in the case of restartable sidecars
+func initContainersCompleted(pod *corev1.Pod) bool {
+	sidecars := map[string]bool{}
+	for _, container := range pod.Spec.InitContainers {
+		if container.RestartPolicy != nil && *container.RestartPolicy == corev1.ContainerRestartPolicyAlways {
+			sidecars[container.Name] = true
+		}
+	}
+
+	if len(pod.Status.InitContainerStatuses) < len(pod.Spec.InitContainers) {
+		return false
+	}
+
+	for _, status := range pod.Status.InitContainerStatuses {
+		if sidecars[status.Name] {
+			if status.Started == nil || !*status.Started {
+				return false
+			}
+			continue
+		}
+		if status.State.Terminated == nil || status.State.Terminated.ExitCode != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 // checkDeploymentCondition checks conditions on deployments
 func (c *ConditionChecker) checkDeploymentCondition(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error) {
 	now := time.Now()
@@ -324,16 +634,13 @@ func (c *ConditionChecker) checkDeploymentCondition(ctx context.Context, conditi
 
 	if c.Config.Name != "" {
 		// Filter by specific deployment name
-		foundDeployment := false
+		filteredDeployments := []appsv1.Deployment{}
 		for _, deployment := range deploymentList.Items {
 			if deployment.Name == c.Config.Name {
-				foundDeployment = true
-				break
+				filteredDeployments = append(filteredDeployments, deployment)
 			}
 		}
-		if !foundDeployment {
-			deploymentList.Items = nil
-		}
+		deploymentList.Items = filteredDeployments
 	}
 
 	if len(deploymentList.Items) == 0 {
@@ -369,6 +676,34 @@ func (c *ConditionChecker) checkDeploymentCondition(ctx context.Context, conditi
 		message = fmt.Sprintf("%d/%d deployments meet condition %s", readyDeployments, totalDeployments, c.Config.Condition)
 	}
 
+	if len(c.Config.Images) > 0 {
+		wrongImages := []string{}
+		for _, deployment := range deploymentList.Items {
+			problems, err := c.workloadPodsOnWrongImage(ctx, deployment.Namespace, deployment.Spec.Selector)
+			if err != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      fmt.Sprintf("Failed to list pods of deployment %s: %s", deployment.Name, err),
+				}, err
+			}
+			// A finished rollout keeps running the pods it has, waiting will not fix their images
+			if len(problems) > 0 && deploymentRolledOut(&deployment) {
+				message := fmt.Sprintf("deployment %s finished rolling out with pods on the wrong image: %s", deployment.Name, strings.Join(problems, "; "))
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      message,
+				}, errors.New(message)
+			}
+			wrongImages = append(wrongImages, problems...)
+		}
+		if len(wrongImages) > 0 {
+			conditionMet = false
+			message = fmt.Sprintf("%s, pods on wrong image: %s", message, strings.Join(wrongImages, "; "))
+		}
+	}
+
 	return &WaitResult{
 		ConditionMet: conditionMet,
 		LastChecked:  now,
@@ -380,6 +715,18 @@ func (c *ConditionChecker) checkDeploymentCondition(ctx context.Context, conditi
 func (c *ConditionChecker) checkServiceCondition(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error) {
 	now := time.Now()
 
+	// Services have no status conditions, anything else would never be met
+	switch conditionType {
+	case "exist", "exists", "endpoints", "loadbalancer", "externalname":
+	default:
+		err := fmt.Errorf("unsupported service condition type %q", conditionType)
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      err.Error(),
+		}, err
+	}
+
 	listOptions := metav1.ListOptions{}
 	if c.Config.Labels != "" {
 		listOptions.LabelSelector = c.Config.Labels
@@ -397,6 +744,17 @@ func (c *ConditionChecker) checkServiceCondition(ctx context.Context, conditionT
 		}, err
 	}
 
+	if c.Config.Name != "" {
+		// Filter by specific service name
+		filteredServices := []corev1.Service{}
+		for _, service := range serviceList.Items {
+			if service.Name == c.Config.Name {
+				filteredServices = append(filteredServices, service)
+			}
+		}
+		serviceList.Items = filteredServices
+	}
+
 	if len(serviceList.Items) == 0 {
 		return &WaitResult{
 			ConditionMet: false,
@@ -405,12 +763,85 @@ func (c *ConditionChecker) checkServiceCondition(ctx context.Context, conditionT
 		}, nil
 	}
 
-	// Services don't typically have conditions like pods/nodes,
-	// so we'll check for existence or other properties
+	readyServices := 0
+	totalServices := len(serviceList.Items)
+	ips := []string{}
+	hostnames := []string{}
+
+	for _, service := range serviceList.Items {
+		switch conditionType {
+		case "exist", "exists":
+			readyServices++
+		case "endpoints":
+			minReady := 1
+			if conditionValue != "" {
+				minReady, err = strconv.Atoi(conditionValue)
+				if err != nil {
+					return &WaitResult{
+						ConditionMet: false,
+						LastChecked:  now,
+						Message:      fmt.Sprintf("Invalid endpoints count %q: %s", conditionValue, err),
+					}, fmt.Errorf("invalid endpoints count %q: %w", conditionValue, err)
+				}
+			}
+
+			addresses, err := c.Client.readyEndpointAddresses(ctx, service.Namespace, service.Name)
+			if err != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      fmt.Sprintf("Failed to list endpoints of service %s: %s", service.Name, err),
+				}, err
+			}
+			if len(addresses) >= minReady {
+				readyServices++
+			}
+			ips = append(ips, addresses...)
+		case "loadbalancer":
+			// Check if load balancer has been assigned
+			if len(service.Status.LoadBalancer.Ingress) > 0 {
+				readyServices++
+			}
+			for _, ingress := range service.Status.LoadBalancer.Ingress {
+				if ingress.IP != "" {
+					ips = append(ips, ingress.IP)
+				}
+				if ingress.Hostname != "" {
+					hostnames = append(hostnames, ingress.Hostname)
+				}
+			}
+		case "externalname":
+			if service.Spec.Type != corev1.ServiceTypeExternalName || service.Spec.ExternalName == "" {
+				continue
+			}
+			hostnames = append(hostnames, service.Spec.ExternalName)
+
+			// Resolution failures are expected until DNS has propagated
+			resolved, err := net.DefaultResolver.LookupHost(ctx, service.Spec.ExternalName)
+			if err == nil && len(resolved) > 0 {
+				readyServices++
+				ips = append(ips, resolved...)
+			}
+		}
+	}
+
+	conditionMet := false
+	var message string
+
+	if c.Config.All {
+		conditionMet = readyServices == totalServices
+		message = fmt.Sprintf("%d/%d services meet condition %s", readyServices, totalServices, c.Config.Condition)
+	} else {
+		conditionMet = readyServices > 0
+		message = fmt.Sprintf("%d/%d services meet condition %s", readyServices, totalServices, c.Config.Condition)
+	}
+
 	return &WaitResult{
-		ConditionMet: true,
+		ConditionMet: conditionMet,
 		LastChecked:  now,
-		Message:      fmt.Sprintf("Found %d matching services", len(serviceList.Items)),
+		Message:      message,
+		IPs:          ips,
+		Hostnames:    hostnames,
 	}, nil
 }
 
@@ -418,32 +849,170 @@ func (c *ConditionChecker) checkServiceCondition(ctx context.Context, conditionT
 func (c *ConditionChecker) checkGenericCondition(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error) {
 	now := time.Now()
 
-	// This is a simplified implementation
-	// In a full implementation, you would use the dynamic client to query arbitrary resources
-	// and apply JSONPath expressions or other condition checks
+	resource, err := c.Client.resolveResource(c.Config.Resource)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to resolve resource type %s: %s", c.Config.Resource, err),
+		}, err
+	}
 
-	dynamicClient, err := dynamic.NewForConfig(c.Client.Config)
+	listOptions := metav1.ListOptions{}
+	if c.Config.Labels != "" {
+		listOptions.LabelSelector = c.Config.Labels
+	}
+	if c.Config.FieldSelector != "" {
+		listOptions.FieldSelector = c.Config.FieldSelector
+	}
+
+	var resourceClient dynamic.ResourceInterface = c.Client.Dynamic.Resource(resource.GroupVersionResource)
+	if resource.Namespaced {
+		resourceClient = c.Client.Dynamic.Resource(resource.GroupVersionResource).Namespace(c.Config.Namespace)
+	}
+
+	objectList, err := resourceClient.List(ctx, listOptions)
 	if err != nil {
 		return &WaitResult{
 			ConditionMet: false,
 			LastChecked:  now,
-			Message:      fmt.Sprintf("Failed to create dynamic client: %s", err),
+			Message:      fmt.Sprintf("Failed to list %s: %s", resource.GroupVersionResource.Resource, err),
 		}, err
 	}
 
-	// For now, we'll return a placeholder implementation
-	// In practice, you would need to:
-	// 1. Parse the resource type to get GroupVersionResource
-	// 2. Use dynamicClient.Resource(gvr).Namespace(namespace).List()
-	// 3. Apply the condition logic to the returned unstructured objects
+	if c.Config.Name != "" {
+		// Filter by specific name
+		filteredObjects := []unstructured.Unstructured{}
+		for _, obj := range objectList.Items {
+			if obj.GetName() == c.Config.Name {
+				filteredObjects = append(filteredObjects, obj)
+			}
+		}
+		objectList.Items = filteredObjects
+	}
+
+	resourceName := resource.GroupVersionResource.Resource
+	if len(objectList.Items) == 0 {
+		if conditionType == "delete" {
+			return &WaitResult{
+				ConditionMet: true,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("All matching %s are deleted", resourceName),
+			}, nil
+		}
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("No matching %s found", resourceName),
+		}, nil
+	}
+
+	if conditionType == "delete" {
+		remaining := []string{}
+		for _, obj := range objectList.Items {
+			remaining = append(remaining, obj.GetName())
+		}
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("%d %s not deleted: %s", len(remaining), resourceName, strings.Join(remaining, ", ")),
+		}, nil
+	}
+
+	var jsonPath *jsonPathCondition
+	if conditionType == "jsonpath" {
+		if jsonPath, err = parseJSONPathCondition(conditionValue); err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      err.Error(),
+			}, err
+		}
+	}
+
+	groupKind := schema.GroupKind{Group: resource.GroupVersionResource.Group, Kind: resource.Kind}
+	readyObjects := 0
+	failedObjects := 0
+	totalObjects := len(objectList.Items)
+	problems := []string{}
+	failures := []string{}
+
+	for i := range objectList.Items {
+		obj := &objectList.Items[i]
+		switch conditionType {
+		case "condition":
+			condition, found := findUnstructuredCondition(obj, conditionValue)
+			if found && condition.Status == "True" && condition.isCurrent(obj.GetGeneration()) {
+				readyObjects++
+			}
+		case "jsonpath":
+			if matched, description := jsonPath.matches(obj.Object); matched {
+				readyObjects++
+			} else {
+				problems = append(problems, fmt.Sprintf("%s: %s", obj.GetName(), description))
+			}
+		case "exist", "exists":
+			readyObjects++
+		case "healthy":
+			rule, found := findHealthRule(groupKind, obj)
+			if !found {
+				err := fmt.Errorf("no built-in health rule for %s, use a condition instead", groupKind)
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      err.Error(),
+				}, err
+			}
+			healthy, description := rule(obj)
+			if healthy {
+				readyObjects++
+			} else {
+				problems = append(problems, fmt.Sprintf("%s: %s", obj.GetName(), description))
+			}
+		case "current":
+			status, description := computeStatus(groupKind, obj)
+			switch status {
+			case statusCurrent:
+				readyObjects++
+			case statusFailed:
+				failedObjects++
+				failures = append(failures, fmt.Sprintf("%s: %s: %s", obj.GetName(), status, description))
+			default:
+				problems = append(problems, fmt.Sprintf("%s: %s: %s", obj.GetName(), status, description))
+			}
+		}
+	}
+
+	// A Failed resource needs intervention, so stop waiting once the condition is out of reach
+	if failedObjects > 0 && (c.Config.All || failedObjects == totalObjects) {
+		message := fmt.Sprintf("%s failed to reconcile: %s", resourceName, strings.Join(failures, "; "))
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      message,
+		}, errors.New(message)
+	}
+	problems = append(problems, failures...)
 
-	_ = dynamicClient // Prevent unused variable error
+	conditionMet := false
+	var message string
+
+	if c.Config.All {
+		conditionMet = readyObjects == totalObjects
+		message = fmt.Sprintf("%d/%d %s meet condition %s", readyObjects, totalObjects, resourceName, c.Config.Condition)
+	} else {
+		conditionMet = readyObjects > 0
+		message = fmt.Sprintf("%d/%d %s meet condition %s", readyObjects, totalObjects, resourceName, c.Config.Condition)
+	}
+	if len(problems) > 0 {
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(problems, "; "))
+	}
 
 	return &WaitResult{
-		ConditionMet: false,
+		ConditionMet: conditionMet,
 		LastChecked:  now,
-		Message:      fmt.Sprintf("Generic condition checking for resource type '%s' not yet implemented", c.Config.Resource),
-	}, fmt.Errorf("generic condition checking not implemented for resource type: %s", c.Config.Resource)
+		Message:      message,
+	}, nil
 }
 
 // checkDaemonSetCondition checks conditions on daemonsets
@@ -469,15 +1038,13 @@ func (c *ConditionChecker) checkDaemonSetCondition(ctx context.Context, conditio
 
 	if c.Config.Name != "" {
 		// Filter by specific daemonset name
-		filteredDS := []interface{}{}
+		filteredDS := []appsv1.DaemonSet{}
 		for _, ds := range daemonsetList.Items {
 			if ds.Name == c.Config.Name {
 				filteredDS = append(filteredDS, ds)
 			}
 		}
-		if len(filteredDS) == 0 {
-			daemonsetList.Items = nil
-		}
+		daemonsetList.Items = filteredDS
 	}
 
 	if len(daemonsetList.Items) == 0 {
@@ -515,6 +1082,34 @@ func (c *ConditionChecker) checkDaemonSetCondition(ctx context.Context, conditio
 		message = fmt.Sprintf("%d/%d daemonsets meet condition %s", readyDS, totalDS, c.Config.Condition)
 	}
 
+	if len(c.Config.Images) > 0 {
+		wrongImages := []string{}
+		for _, ds := range daemonsetList.Items {
+			problems, err := c.workloadPodsOnWrongImage(ctx, ds.Namespace, ds.Spec.Selector)
+			if err != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      fmt.Sprintf("Failed to list pods of daemonset %s: %s", ds.Name, err),
+				}, err
+			}
+			// A finished rollout keeps running the pods it has, waiting will not fix their images
+			if len(problems) > 0 && daemonSetRolledOut(&ds) {
+				message := fmt.Sprintf("daemonset %s finished rolling out with pods on the wrong image: %s", ds.Name, strings.Join(problems, "; "))
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      message,
+				}, errors.New(message)
+			}
+			wrongImages = append(wrongImages, problems...)
+		}
+		if len(wrongImages) > 0 {
+			conditionMet = false
+			message = fmt.Sprintf("%s, pods on wrong image: %s", message, strings.Join(wrongImages, "; "))
+		}
+	}
+
 	return &WaitResult{
 		ConditionMet: conditionMet,
 		LastChecked:  now,
@@ -545,15 +1140,13 @@ func (c *ConditionChecker) checkStatefulSetCondition(ctx context.Context, condit
 
 	if c.Config.Name != "" {
 		// Filter by specific statefulset name
-		filteredSS := []interface{}{}
+		filteredSS := []appsv1.StatefulSet{}
 		for _, ss := range statefulsetList.Items {
 			if ss.Name == c.Config.Name {
 				filteredSS = append(filteredSS, ss)
 			}
 		}
-		if len(filteredSS) == 0 {
-			statefulsetList.Items = nil
-		}
+		statefulsetList.Items = filteredSS
 	}
 
 	if len(statefulsetList.Items) == 0 {
@@ -587,6 +1180,34 @@ func (c *ConditionChecker) checkStatefulSetCondition(ctx context.Context, condit
 		message = fmt.Sprintf("%d/%d statefulsets meet condition %s", readySS, totalSS, c.Config.Condition)
 	}
 
+	if len(c.Config.Images) > 0 {
+		wrongImages := []string{}
+		for _, ss := range statefulsetList.Items {
+			problems, err := c.workloadPodsOnWrongImage(ctx, ss.Namespace, ss.Spec.Selector)
+			if err != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      fmt.Sprintf("Failed to list pods of statefulset %s: %s", ss.Name, err),
+				}, err
+			}
+			// A finished rollout keeps running the pods it has, waiting will not fix their images
+			if len(problems) > 0 && statefulSetRolledOut(&ss) {
+				message := fmt.Sprintf("statefulset %s finished rolling out with pods on the wrong image: %s", ss.Name, strings.Join(problems, "; "))
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      message,
+				}, errors.New(message)
+			}
+			wrongImages = append(wrongImages, problems...)
+		}
+		if len(wrongImages) > 0 {
+			conditionMet = false
+			message = fmt.Sprintf("%s, pods on wrong image: %s", message, strings.Join(wrongImages, "; "))
+		}
+	}
+
 	return &WaitResult{
 		ConditionMet: conditionMet,
 		LastChecked:  now,
@@ -617,15 +1238,13 @@ func (c *ConditionChecker) checkJobCondition(ctx context.Context, conditionType,
 
 	if c.Config.Name != "" {
 		// Filter by specific job name
-		filteredJobs := []interface{}{}
+		filteredJobs := []batchv1.Job{}
 		for _, job := range jobList.Items {
 			if job.Name == c.Config.Name {
 				filteredJobs = append(filteredJobs, job)
 			}
 		}
-		if len(filteredJobs) == 0 {
-			jobList.Items = nil
-		}
+		jobList.Items = filteredJobs
 	}
 
 	if len(jobList.Items) == 0 {
@@ -637,23 +1256,56 @@ func (c *ConditionChecker) checkJobCondition(ctx context.Context, conditionType,
 	}
 
 	readyJobs := 0
+	failedJobs := 0
 	totalJobs := len(jobList.Items)
+	progress := []string{}
+	failures := []string{}
 
-	for _, job := range jobList.Items {
-		if conditionType == "condition" {
+	// Waiting for completion honors completions, indexes and failure policies
+	waitForCompletion := conditionType == "complete" || (conditionType == "condition" && conditionValue == string(batchv1.JobComplete))
+
+	for i := range jobList.Items {
+		job := &jobList.Items[i]
+		if waitForCompletion {
+			if succeeded, description := jobSucceeded(job); succeeded {
+				readyJobs++
+			} else if failed, reason := jobFailed(job); failed {
+				failedJobs++
+				podFailure, err := c.jobPodFailure(ctx, job)
+				if err != nil {
+					return &WaitResult{
+						ConditionMet: false,
+						LastChecked:  now,
+						Message:      fmt.Sprintf("Failed to list pods of job %s: %s", job.Name, err),
+					}, err
+				}
+				if podFailure != "" {
+					reason = fmt.Sprintf("%s, %s", reason, podFailure)
+				}
+				failures = append(failures, fmt.Sprintf("job %s failed: %s", job.Name, reason))
+			} else {
+				progress = append(progress, fmt.Sprintf("%s: %s", job.Name, description))
+			}
+		} else if conditionType == "condition" {
 			for _, condition := range job.Status.Conditions {
 				if string(condition.Type) == conditionValue && string(condition.Status) == "True" {
 					readyJobs++
 					break
 				}
 			}
-		} else if conditionType == "complete" {
-			if job.Status.Succeeded > 0 {
-				readyJobs++
-			}
 		}
 	}
 
+	// A failed job can no longer complete, so stop waiting once the condition is out of reach
+	if failedJobs > 0 && (c.Config.All || failedJobs == totalJobs) {
+		message := strings.Join(failures, "; ")
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      message,
+		}, errors.New(message)
+	}
+
 	conditionMet := false
 	var message string
 
@@ -664,6 +1316,9 @@ func (c *ConditionChecker) checkJobCondition(ctx context.Context, conditionType,
 		conditionMet = readyJobs > 0
 		message = fmt.Sprintf("%d/%d jobs meet condition %s", readyJobs, totalJobs, c.Config.Condition)
 	}
+	if problems := append(progress, failures...); len(problems) > 0 {
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(problems, "; "))
+	}
 
 	return &WaitResult{
 		ConditionMet: conditionMet,
@@ -695,15 +1350,13 @@ func (c *ConditionChecker) checkCronJobCondition(ctx context.Context, conditionT
 
 	if c.Config.Name != "" {
 		// Filter by specific cronjob name
-		filteredCJs := []interface{}{}
+		filteredCJs := []batchv1.CronJob{}
 		for _, cj := range cronjobList.Items {
 			if cj.Name == c.Config.Name {
 				filteredCJs = append(filteredCJs, cj)
 			}
 		}
-		if len(filteredCJs) == 0 {
-			cronjobList.Items = nil
-		}
+		cronjobList.Items = filteredCJs
 	}
 
 	if len(cronjobList.Items) == 0 {
@@ -714,16 +1367,68 @@ func (c *ConditionChecker) checkCronJobCondition(ctx context.Context, conditionT
 		}, nil
 	}
 
-	// For cronjobs, we can check existence or JSONPath expressions
+	if c.Config.Trigger {
+		if err := c.triggerCronJobs(ctx, cronjobList.Items); err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to trigger cronjob: %s", err),
+			}, err
+		}
+	}
+
+	var jobs []batchv1.Job
+	if conditionType == "job" {
+		jobList, err := c.Client.Clientset.BatchV1().Jobs(c.Config.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to list jobs: %s", err),
+			}, err
+		}
+		jobs = jobList.Items
+	}
+
 	readyCJs := 0
 	totalCJs := len(cronjobList.Items)
+	problems := []string{}
 
-	for range cronjobList.Items {
-		if conditionType == "jsonpath" {
-			// For JSONPath conditions like jsonpath={.metadata.name}, just check existence
-			readyCJs++
-		} else if conditionType == "exist" || conditionType == "exists" {
+	for i := range cronjobList.Items {
+		cj := &cronjobList.Items[i]
+		if conditionType == "exist" || conditionType == "exists" {
 			readyCJs++
+		} else if conditionType == "suspended" {
+			suspended := cj.Spec.Suspend != nil && *cj.Spec.Suspend
+			if strconv.FormatBool(suspended) == strings.ToLower(conditionValue) {
+				readyCJs++
+			}
+		} else if conditionType == "lastsuccess" {
+			after, err := time.Parse(time.RFC3339, conditionValue)
+			if err != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      fmt.Sprintf("Invalid lastsuccess timestamp %q, must be RFC3339", conditionValue),
+				}, err
+			}
+			if cj.Status.LastSuccessfulTime != nil && cj.Status.LastSuccessfulTime.After(after) {
+				readyCJs++
+			}
+		} else if conditionType == "job" {
+			completed, problem, err := c.cronJobCompleted(ctx, cj, jobs)
+			if err != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      problem,
+				}, err
+			}
+			if completed {
+				readyCJs++
+			} else if problem != "" {
+				problems = append(problems, problem)
+			}
 		}
 	}
 
@@ -737,6 +1442,9 @@ func (c *ConditionChecker) checkCronJobCondition(ctx context.Context, conditionT
 		conditionMet = readyCJs > 0
 		message = fmt.Sprintf("%d/%d cronjobs meet condition %s", readyCJs, totalCJs, c.Config.Condition)
 	}
+	if len(problems) > 0 {
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(problems, "; "))
+	}
 
 	return &WaitResult{
 		ConditionMet: conditionMet,
@@ -768,15 +1476,13 @@ func (c *ConditionChecker) checkIngressCondition(ctx context.Context, conditionT
 
 	if c.Config.Name != "" {
 		// Filter by specific ingress name
-		filteredIngresses := []interface{}{}
+		filteredIngresses := []networkingv1.Ingress{}
 		for _, ing := range ingressList.Items {
 			if ing.Name == c.Config.Name {
 				filteredIngresses = append(filteredIngresses, ing)
 			}
 		}
-		if len(filteredIngresses) == 0 {
-			ingressList.Items = nil
-		}
+		ingressList.Items = filteredIngresses
 	}
 
 	if len(ingressList.Items) == 0 {
@@ -787,21 +1493,49 @@ func (c *ConditionChecker) checkIngressCondition(ctx context.Context, conditionT
 		}, nil
 	}
 
-	// For ingress, we can check existence or load balancer IP assignment
+	// For ingress, we can check existence, load balancer assignment and the
+	// objects the ingress depends on (backend services, TLS secrets, class)
 	readyIngresses := 0
 	totalIngresses := len(ingressList.Items)
+	ips := []string{}
+	hostnames := []string{}
+	problems := []string{}
 
 	for _, ing := range ingressList.Items {
-		if conditionType == "jsonpath" {
-			// For JSONPath conditions like jsonpath={.metadata.name}, just check existence
-			readyIngresses++
-		} else if conditionType == "exist" || conditionType == "exists" {
+		for _, lbIngress := range ing.Status.LoadBalancer.Ingress {
+			if lbIngress.IP != "" {
+				ips = append(ips, lbIngress.IP)
+			}
+			if lbIngress.Hostname != "" {
+				hostnames = append(hostnames, lbIngress.Hostname)
+			}
+		}
+
+		if conditionType == "exist" || conditionType == "exists" {
 			readyIngresses++
 		} else if conditionType == "loadbalancer" {
 			// Check if load balancer has been assigned
 			if len(ing.Status.LoadBalancer.Ingress) > 0 {
 				readyIngresses++
 			}
+		} else if conditionType == "backends" || conditionType == "tls" || conditionType == "class" || conditionType == "ready" {
+			ingressProblems, err := c.checkIngressDependencies(ctx, &ing, conditionType)
+			if err != nil {
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      fmt.Sprintf("Failed to check dependencies of ingress %s: %s", ing.Name, err),
+				}, err
+			}
+			if conditionType == "ready" && len(ing.Status.LoadBalancer.Ingress) == 0 {
+				ingressProblems = append(ingressProblems, "no load balancer address assigned")
+			}
+
+			if len(ingressProblems) == 0 {
+				readyIngresses++
+			} else {
+				problems = append(problems, fmt.Sprintf("%s: %s", ing.Name, strings.Join(ingressProblems, ", ")))
+			}
 		}
 	}
 
@@ -815,10 +1549,457 @@ func (c *ConditionChecker) checkIngressCondition(ctx context.Context, conditionT
 		conditionMet = readyIngresses > 0
 		message = fmt.Sprintf("%d/%d ingresses meet condition %s", readyIngresses, totalIngresses, c.Config.Condition)
 	}
+	if len(problems) > 0 {
+		message = fmt.Sprintf("%s (%s)", message, strings.Join(problems, "; "))
+	}
 
 	return &WaitResult{
 		ConditionMet: conditionMet,
 		LastChecked:  now,
 		Message:      message,
+		IPs:          ips,
+		Hostnames:    hostnames,
 	}, nil
 }
+
+// checkIngressDependencies checks the objects an ingress depends on and returns
+// what is not ready yet. conditionType selects "backends", "tls", "class" or
+// "ready" for all of them.
+func (c *ConditionChecker) checkIngressDependencies(ctx context.Context, ing *networkingv1.Ingress, conditionType string) ([]string, error) {
+	problems := []string{}
+	checkAll := conditionType == "ready"
+
+	if checkAll || conditionType == "class" {
+		if ing.Spec.IngressClassName != nil && *ing.Spec.IngressClassName != "" {
+			_, err := c.Client.Clientset.NetworkingV1().IngressClasses().Get(ctx, *ing.Spec.IngressClassName, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				problems = append(problems, fmt.Sprintf("ingress class %s not found", *ing.Spec.IngressClassName))
+			} else if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if checkAll || conditionType == "tls" {
+		for _, tls := range ing.Spec.TLS {
+			if tls.SecretName == "" {
+				continue
+			}
+			_, err := c.Client.Clientset.CoreV1().Secrets(ing.Namespace).Get(ctx, tls.SecretName, metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				problems = append(problems, fmt.Sprintf("TLS secret %s not found", tls.SecretName))
+			} else if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if checkAll || conditionType == "backends" {
+		for _, serviceName := range ingressBackendServices(ing) {
+			addresses, err := c.Client.readyEndpointAddresses(ctx, ing.Namespace, serviceName)
+			if err != nil {
+				return nil, err
+			}
+			if len(addresses) == 0 {
+				problems = append(problems, fmt.Sprintf("backend service %s has no ready endpoints", serviceName))
+			}
+		}
+	}
+
+	return problems, nil
+}
+
+// ingressBackendServices returns the distinct backend service names referenced by an ingress
+func ingressBackendServices(ing *networkingv1.Ingress) []string {
+	seen := map[string]bool{}
+	services := []string{}
+
+	addBackend := func(backend *networkingv1.IngressBackend) {
+		if backend == nil || backend.Service == nil || seen[backend.Service.Name] {
+			return
+		}
+		seen[backend.Service.Name] = true
+		services = append(services, backend.Service.Name)
+	}
+
+	addBackend(ing.Spec.DefaultBackend)
+	for _, rule := range ing.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			addBackend(&path.Backend)
+		}
+	}
+
+	return services
+}
+
+// checkPersistentVolumeClaimCondition checks conditions on persistentvolumeclaims
+func (c *ConditionChecker) checkPersistentVolumeClaimCondition(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error) {
+	now := time.Now()
+
+	listOptions := metav1.ListOptions{}
+	if c.Config.Labels != "" {
+		listOptions.LabelSelector = c.Config.Labels
+	}
+	if c.Config.FieldSelector != "" {
+		listOptions.FieldSelector = c.Config.FieldSelector
+	}
+
+	pvcList, err := c.Client.Clientset.CoreV1().PersistentVolumeClaims(c.Config.Namespace).List(ctx, listOptions)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to list persistentvolumeclaims: %s", err),
+		}, err
+	}
+
+	if c.Config.Name != "" {
+		// Filter by specific persistentvolumeclaim name
+		filteredPVCs := []corev1.PersistentVolumeClaim{}
+		for _, pvc := range pvcList.Items {
+			if pvc.Name == c.Config.Name {
+				filteredPVCs = append(filteredPVCs, pvc)
+			}
+		}
+		pvcList.Items = filteredPVCs
+	}
+
+	if len(pvcList.Items) == 0 {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      "No matching persistentvolumeclaims found",
+		}, nil
+	}
+
+	readyPVCs := 0
+	waitingForConsumer := 0
+	snapshotWaits := []string{}
+	totalPVCs := len(pvcList.Items)
+	storageClasses := map[string]*storagev1.StorageClass{}
+
+	for _, pvc := range pvcList.Items {
+		switch conditionType {
+		case "condition":
+			for _, condition := range pvc.Status.Conditions {
+				if string(condition.Type) == conditionValue && condition.Status == corev1.ConditionTrue {
+					readyPVCs++
+					break
+				}
+			}
+		case "phase":
+			if string(pvc.Status.Phase) == conditionValue {
+				readyPVCs++
+				continue
+			}
+
+			// Claims of WaitForFirstConsumer storage classes stay Pending until a pod using them is scheduled
+			if conditionValue == string(corev1.ClaimBound) && pvc.Status.Phase == corev1.ClaimPending {
+				waitForFirstConsumer, err := c.isWaitingForFirstConsumer(ctx, &pvc, storageClasses)
+				if err != nil {
+					return &WaitResult{
+						ConditionMet: false,
+						LastChecked:  now,
+						Message:      fmt.Sprintf("Failed to get storage class of persistentvolumeclaim %s: %s", pvc.Name, err),
+					}, err
+				}
+				if waitForFirstConsumer {
+					readyPVCs++
+					waitingForConsumer++
+					continue
+				}
+			}
+
+			// Claims restored from a VolumeSnapshot stay Pending until the snapshot is ready to use
+			if pvc.Status.Phase == corev1.ClaimPending {
+				snapshotWait, err := c.pendingSnapshot(ctx, &pvc)
+				if err != nil {
+					return &WaitResult{
+						ConditionMet: false,
+						LastChecked:  now,
+						Message:      fmt.Sprintf("Failed to get volumesnapshot of persistentvolumeclaim %s: %s", pvc.Name, err),
+					}, err
+				}
+				if snapshotWait != "" {
+					snapshotWaits = append(snapshotWaits, snapshotWait)
+				}
+			}
+		case "resize":
+			if isPersistentVolumeClaimResized(&pvc) {
+				readyPVCs++
+			}
+		}
+	}
+
+	conditionMet := false
+	var message string
+
+	if c.Config.All {
+		conditionMet = readyPVCs == totalPVCs
+	} else {
+		conditionMet = readyPVCs > 0
+	}
+	message = fmt.Sprintf("%d/%d persistentvolumeclaims meet condition %s", readyPVCs, totalPVCs, c.Config.Condition)
+	if waitingForConsumer > 0 {
+		message = fmt.Sprintf("%s (%d pending until a pod using them is scheduled)", message, waitingForConsumer)
+	}
+	if len(snapshotWaits) > 0 {
+		message = fmt.Sprintf("%s; restoring from snapshots: %s", message, strings.Join(snapshotWaits, ", "))
+	}
+
+	return &WaitResult{
+		ConditionMet: conditionMet,
+		LastChecked:  now,
+		Message:      message,
+	}, nil
+}
+
+// isWaitingForFirstConsumer reports whether a Pending claim uses a WaitForFirstConsumer
+// storage class and no node has been selected for it yet
+func (c *ConditionChecker) isWaitingForFirstConsumer(ctx context.Context, pvc *corev1.PersistentVolumeClaim, storageClasses map[string]*storagev1.StorageClass) (bool, error) {
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return false, nil
+	}
+	if _, selected := pvc.Annotations["volume.kubernetes.io/selected-node"]; selected {
+		return false, nil
+	}
+
+	storageClassName := *pvc.Spec.StorageClassName
+	storageClass, cached := storageClasses[storageClassName]
+	if !cached {
+		var err error
+		storageClass, err = c.Client.Clientset.StorageV1().StorageClasses().Get(ctx, storageClassName, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, err
+		}
+		storageClasses[storageClassName] = storageClass
+	}
+
+	return storageClass.VolumeBindingMode != nil && *storageClass.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer, nil
+}
+
+// isPersistentVolumeClaimResized reports whether a claim has no resize in progress
+// and its capacity satisfies the requested storage
+func isPersistentVolumeClaimResized(pvc *corev1.PersistentVolumeClaim) bool {
+	for _, condition := range pvc.Status.Conditions {
+		if (condition.Type == corev1.PersistentVolumeClaimFileSystemResizePending || condition.Type == corev1.PersistentVolumeClaimResizing) &&
+			condition.Status == corev1.ConditionTrue {
+			return false
+		}
+	}
+
+	requested, hasRequest := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity, hasCapacity := pvc.Status.Capacity[corev1.ResourceStorage]
+	if !hasRequest || !hasCapacity {
+		return false
+	}
+
+	return capacity.Cmp(requested) >= 0
+}
+
+// checkPersistentVolumeCondition checks conditions on persistentvolumes
+func (c *ConditionChecker) checkPersistentVolumeCondition(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error) {
+	now := time.Now()
+
+	listOptions := metav1.ListOptions{}
+	if c.Config.Labels != "" {
+		listOptions.LabelSelector = c.Config.Labels
+	}
+	if c.Config.FieldSelector != "" {
+		listOptions.FieldSelector = c.Config.FieldSelector
+	}
+
+	pvList, err := c.Client.Clientset.CoreV1().PersistentVolumes().List(ctx, listOptions)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to list persistentvolumes: %s", err),
+		}, err
+	}
+
+	if c.Config.Name != "" {
+		// Filter by specific persistentvolume name
+		filteredPVs := []corev1.PersistentVolume{}
+		for _, pv := range pvList.Items {
+			if pv.Name == c.Config.Name {
+				filteredPVs = append(filteredPVs, pv)
+			}
+		}
+		pvList.Items = filteredPVs
+	}
+
+	if len(pvList.Items) == 0 {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      "No matching persistentvolumes found",
+		}, nil
+	}
+
+	readyPVs := 0
+	totalPVs := len(pvList.Items)
+
+	for _, pv := range pvList.Items {
+		if conditionType == "phase" {
+			if string(pv.Status.Phase) == conditionValue {
+				readyPVs++
+			}
+		}
+	}
+
+	conditionMet := false
+	var message string
+
+	if c.Config.All {
+		conditionMet = readyPVs == totalPVs
+		message = fmt.Sprintf("%d/%d persistentvolumes meet condition %s", readyPVs, totalPVs, c.Config.Condition)
+	} else {
+		conditionMet = readyPVs > 0
+		message = fmt.Sprintf("%d/%d persistentvolumes meet condition %s", readyPVs, totalPVs, c.Config.Condition)
+	}
+
+	return &WaitResult{
+		ConditionMet: conditionMet,
+		LastChecked:  now,
+		Message:      message,
+	}, nil
+}
+
+// namespaceTerminationConditions are the namespace conditions explaining why deletion is blocked
+var namespaceTerminationConditions = []corev1.NamespaceConditionType{
+	corev1.NamespaceDeletionDiscoveryFailure,
+	corev1.NamespaceDeletionGVParsingFailure,
+	corev1.NamespaceDeletionContentFailure,
+	corev1.NamespaceContentRemaining,
+	corev1.NamespaceFinalizersRemaining,
+}
+
+// checkNamespaceCondition checks conditions on namespaces
+func (c *ConditionChecker) checkNamespaceCondition(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error) {
+	now := time.Now()
+
+	listOptions := metav1.ListOptions{}
+	if c.Config.Labels != "" {
+		listOptions.LabelSelector = c.Config.Labels
+	}
+	if c.Config.FieldSelector != "" {
+		listOptions.FieldSelector = c.Config.FieldSelector
+	}
+
+	namespaceList, err := c.Client.Clientset.CoreV1().Namespaces().List(ctx, listOptions)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to list namespaces: %s", err),
+		}, err
+	}
+
+	if c.Config.Name != "" {
+		// Filter by specific namespace name
+		filteredNamespaces := []corev1.Namespace{}
+		for _, namespace := range namespaceList.Items {
+			if namespace.Name == c.Config.Name {
+				filteredNamespaces = append(filteredNamespaces, namespace)
+			}
+		}
+		namespaceList.Items = filteredNamespaces
+	}
+
+	if conditionType == "delete" {
+		if len(namespaceList.Items) == 0 {
+			return &WaitResult{
+				ConditionMet: true,
+				LastChecked:  now,
+				Message:      "All matching namespaces are deleted",
+			}, nil
+		}
+
+		remaining := []string{}
+		for _, namespace := range namespaceList.Items {
+			remaining = append(remaining, describeNamespaceTermination(&namespace))
+		}
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("%d namespaces not deleted: %s", len(namespaceList.Items), strings.Join(remaining, "; ")),
+		}, nil
+	}
+
+	if len(namespaceList.Items) == 0 {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      "No matching namespaces found",
+		}, nil
+	}
+
+	readyNamespaces := 0
+	totalNamespaces := len(namespaceList.Items)
+
+	for _, namespace := range namespaceList.Items {
+		if conditionType == "condition" {
+			for _, condition := range namespace.Status.Conditions {
+				if string(condition.Type) == conditionValue && condition.Status == corev1.ConditionTrue {
+					readyNamespaces++
+					break
+				}
+			}
+		} else if conditionType == "phase" {
+			if string(namespace.Status.Phase) == conditionValue {
+				readyNamespaces++
+			}
+		}
+	}
+
+	conditionMet := false
+	var message string
+
+	if c.Config.All {
+		conditionMet = readyNamespaces == totalNamespaces
+		message = fmt.Sprintf("%d/%d namespaces meet condition %s", readyNamespaces, totalNamespaces, c.Config.Condition)
+	} else {
+		conditionMet = readyNamespaces > 0
+		message = fmt.Sprintf("%d/%d namespaces meet condition %s", readyNamespaces, totalNamespaces, c.Config.Condition)
+	}
+
+	return &WaitResult{
+		ConditionMet: conditionMet,
+		LastChecked:  now,
+		Message:      message,
+	}, nil
+}
+
+// isNamespaceResource reports whether a resource type names namespaces
+func isNamespaceResource(resourceType string) bool {
+	return resourceType == "namespaces" || resourceType == "namespace" || resourceType == "ns"
+}
+
+// describeNamespaceTermination explains why a namespace still exists, including
+// the conditions reporting remaining content and finalizers blocking deletion
+func describeNamespaceTermination(namespace *corev1.Namespace) string {
+	if namespace.DeletionTimestamp == nil {
+		return fmt.Sprintf("%s is %s and not being deleted", namespace.Name, namespace.Status.Phase)
+	}
+
+	blocking := []string{}
+	for _, conditionType := range namespaceTerminationConditions {
+		for _, condition := range namespace.Status.Conditions {
+			if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+				blocking = append(blocking, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+			}
+		}
+	}
+
+	if len(blocking) == 0 {
+		return fmt.Sprintf("%s is Terminating", namespace.Name)
+	}
+	return fmt.Sprintf("%s is Terminating (%s)", namespace.Name, strings.Join(blocking, ", "))
+}
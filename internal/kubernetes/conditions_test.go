@@ -0,0 +1,45 @@
+package kubernetes
+
+import "testing"
+
+func TestValidateOptions(t *testing.T) {
+	maxRestarts := int32(0)
+	images := map[string]string{"web": "nginx:1.25"}
+
+	tests := []struct {
+		name      string
+		config    WaitConfig
+		wantError bool
+	}{
+		{name: "no options", config: WaitConfig{Resource: "cronjobs", Condition: "exists=true"}},
+		{name: "trigger waiting for the job", config: WaitConfig{Resource: "cronjobs", Condition: "job=completed", Trigger: true}},
+		{name: "trigger with existence", config: WaitConfig{Resource: "cronjobs", Condition: "exists=true", Trigger: true}, wantError: true},
+		{name: "trigger with suspended", config: WaitConfig{Resource: "cronjobs", Condition: "suspended=false", Trigger: true}, wantError: true},
+		{name: "trigger with healthy", config: WaitConfig{Resource: "cronjobs", Condition: "healthy", Trigger: true}, wantError: true},
+		{name: "children_of with a pod condition", config: WaitConfig{Resource: "pods", Condition: "condition=Ready", ChildrenOf: "deployment/web"}},
+		{name: "children_of with current", config: WaitConfig{Resource: "pods", Condition: "current", ChildrenOf: "deployment/web"}, wantError: true},
+		{name: "max_restarts with jsonpath", config: WaitConfig{Resource: "pods", Condition: "jsonpath={.status.phase}=Running", MaxRestarts: &maxRestarts}, wantError: true},
+		{name: "images with a rollout", config: WaitConfig{Resource: "deployments", Condition: "condition=Available", Images: images}},
+		{name: "images with healthy", config: WaitConfig{Resource: "deployments", Condition: "healthy", Images: images}, wantError: true},
+		{name: "images with delete", config: WaitConfig{Resource: "deployments", Condition: "delete", Images: images}, wantError: true},
+		{name: "current without options", config: WaitConfig{Resource: "pods", Condition: "current"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checker := &ConditionChecker{Config: &tt.config}
+			conditionType, conditionValue, err := checker.parseCondition()
+			if err != nil {
+				t.Fatalf("unexpected error parsing %q: %s", tt.config.Condition, err)
+			}
+
+			err = checker.validateOptions(conditionType, conditionValue)
+			if tt.wantError && err == nil {
+				t.Errorf("validateOptions(%q) expected an error", tt.config.Condition)
+			}
+			if !tt.wantError && err != nil {
+				t.Errorf("validateOptions(%q) unexpected error: %s", tt.config.Condition, err)
+			}
+		})
+	}
+}
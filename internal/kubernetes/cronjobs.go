@@ -0,0 +1,154 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+)
+
+// triggerLabel marks the Jobs created by a wait with its trigger ID, so a
+// retried create can find the Job an ambiguous failure may have created
+const triggerLabel = "kubewait.io/trigger"
+
+// triggerCronJobs creates a Job from the template of every CronJob that has
+// not been triggered yet, like "kubectl create job --from=cronjob/<name>"
+func (c *ConditionChecker) triggerCronJobs(ctx context.Context, cronjobs []batchv1.CronJob) error {
+	if c.triggeredJobs == nil {
+		c.triggeredJobs = map[string]string{}
+		c.triggerID = string(uuid.NewUUID())
+	}
+
+	for i := range cronjobs {
+		cj := &cronjobs[i]
+		if _, triggered := c.triggeredJobs[cj.Name]; triggered {
+			continue
+		}
+
+		// A create that timed out or failed with a server error may still
+		// have created the Job, look for it before creating another one
+		existing, err := c.Client.Clientset.BatchV1().Jobs(cj.Namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: triggerLabel + "=" + c.triggerID,
+		})
+		if err != nil {
+			return fmt.Errorf("listing jobs triggered from cronjob %s: %w", cj.Name, err)
+		}
+		if owned := jobsOwnedBy(cj, existing.Items); len(owned) > 0 {
+			c.triggeredJobs[cj.Name] = owned[0].Name
+			continue
+		}
+
+		labels := map[string]string{triggerLabel: c.triggerID}
+		for key, value := range cj.Spec.JobTemplate.Labels {
+			labels[key] = value
+		}
+
+		annotations := map[string]string{"cronjob.kubernetes.io/instantiate": "manual"}
+		for key, value := range cj.Spec.JobTemplate.Annotations {
+			annotations[key] = value
+		}
+
+		// Job names end up in the 63 character job-name label of their pods
+		prefix := cj.Name
+		if len(prefix) > 52 {
+			prefix = prefix[:52]
+		}
+
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				GenerateName:    prefix + "-",
+				Namespace:       cj.Namespace,
+				Labels:          labels,
+				Annotations:     annotations,
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(cj, batchv1.SchemeGroupVersion.WithKind("CronJob"))},
+			},
+			Spec: cj.Spec.JobTemplate.Spec,
+		}
+
+		created, err := c.Client.Clientset.BatchV1().Jobs(cj.Namespace).Create(ctx, job, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("creating job from cronjob %s: %w", cj.Name, err)
+		}
+		c.triggeredJobs[cj.Name] = created.Name
+	}
+
+	return nil
+}
+
+// cronJobCompleted reports whether the Job triggered from a CronJob, or any Job
+// created by the CronJob when none was triggered, completed since the wait
+// started. A failed triggered Job is returned as an error.
+func (c *ConditionChecker) cronJobCompleted(ctx context.Context, cj *batchv1.CronJob, jobs []batchv1.Job) (bool, string, error) {
+	// Jobs are listed for the whole namespace, only those of this CronJob count
+	jobs = jobsOwnedBy(cj, jobs)
+
+	if jobName, triggered := c.triggeredJobs[cj.Name]; triggered {
+		for i := range jobs {
+			job := &jobs[i]
+			if job.Name != jobName {
+				continue
+			}
+
+			if succeeded, _ := jobSucceeded(job); succeeded {
+				return true, "", nil
+			}
+			if failed, reason := jobFailed(job); failed {
+				podFailure, err := c.jobPodFailure(ctx, job)
+				if err != nil {
+					return false, fmt.Sprintf("Failed to list pods of job %s: %s", job.Name, err), err
+				}
+				if podFailure != "" {
+					reason = fmt.Sprintf("%s, %s", reason, podFailure)
+				}
+				message := fmt.Sprintf("job %s triggered from cronjob %s failed: %s", job.Name, cj.Name, reason)
+				return false, message, errors.New(message)
+			}
+
+			_, description := jobSucceeded(job)
+			return false, fmt.Sprintf("%s: job %s %s", cj.Name, job.Name, description), nil
+		}
+		return false, fmt.Sprintf("%s: job %s not found yet", cj.Name, jobName), nil
+	}
+
+	// Server timestamps have a resolution of one second
+	started := c.waitStarted.Truncate(time.Second)
+	for i := range jobs {
+		job := &jobs[i]
+		if succeeded, _ := jobSucceeded(job); !succeeded {
+			continue
+		}
+		if finished := jobFinishedAt(job); !finished.IsZero() && !finished.Before(started) {
+			return true, "", nil
+		}
+	}
+
+	return false, fmt.Sprintf("%s: no job completed since the wait started (%d active)", cj.Name, len(cj.Status.Active)), nil
+}
+
+// jobsOwnedBy returns the jobs controlled by a CronJob
+func jobsOwnedBy(cj *batchv1.CronJob, jobs []batchv1.Job) []batchv1.Job {
+	owned := []batchv1.Job{}
+	for _, job := range jobs {
+		if controllerRef := metav1.GetControllerOf(&job); controllerRef != nil && controllerRef.UID == cj.UID {
+			owned = append(owned, job)
+		}
+	}
+	return owned
+}
+
+// jobFinishedAt returns when a successful job finished, or the zero time if unknown
+func jobFinishedAt(job *batchv1.Job) time.Time {
+	if job.Status.CompletionTime != nil {
+		return job.Status.CompletionTime.Time
+	}
+	for _, conditionType := range []batchv1.JobConditionType{batchv1.JobComplete, jobSuccessCriteriaMet} {
+		if condition, found := jobCondition(job, conditionType); found {
+			return condition.LastTransitionTime.Time
+		}
+	}
+	return time.Time{}
+}
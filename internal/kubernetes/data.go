@@ -0,0 +1,176 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DataConfig holds the configuration for waiting on the keys of a Secret or ConfigMap
+type DataConfig struct {
+	Kind          string            // "secret" or "configmap"
+	Name          string            // Object name
+	Namespace     string            // Object namespace
+	RequiredKeys  []string          // Keys that must have non-empty values
+	Patterns      map[string]string // Regular expressions the values of keys must match
+	ExposeKeys    []string          // Keys whose values are returned in the result
+	Timeout       time.Duration     // Maximum wait time
+	CheckInterval time.Duration     // Interval between checks
+}
+
+// DataResult holds the result of a Secret or ConfigMap wait
+type DataResult struct {
+	WaitResult
+	Values map[string]string // Values of the exposed keys
+}
+
+// DataChecker waits for a Secret or ConfigMap to contain the required keys
+type DataChecker struct {
+	Client *Client
+	Config *DataConfig
+
+	patterns map[string]*regexp.Regexp
+	data     map[string]string
+}
+
+// WaitForData waits until the object exists and every required key has a non-empty, matching value
+func (c *DataChecker) WaitForData(ctx context.Context) (*DataResult, error) {
+	c.patterns = make(map[string]*regexp.Regexp, len(c.Config.Patterns))
+	for key, pattern := range c.Config.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern for key %s: %w", key, err)
+		}
+		c.patterns[key] = re
+	}
+
+	description := fmt.Sprintf("%s %s/%s keys", c.Config.Kind, c.Config.Namespace, c.Config.Name)
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, description, c.CheckData)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	dataResult := &DataResult{WaitResult: *result, Values: map[string]string{}}
+	if result.ConditionMet {
+		for _, key := range c.Config.ExposeKeys {
+			dataResult.Values[key] = c.data[key]
+		}
+	}
+	return dataResult, err
+}
+
+// CheckData performs a single check of the keys of the object
+func (c *DataChecker) CheckData(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	data, err := c.readData(ctx)
+	if apierrors.IsNotFound(err) {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("%s %s not found in namespace %s", c.Config.Kind, c.Config.Name, c.Config.Namespace),
+		}, nil
+	}
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to get %s %s: %s", c.Config.Kind, c.Config.Name, err),
+		}, err
+	}
+	c.data = data
+
+	// Only key names are reported, values may be sensitive
+	var missing, mismatched []string
+	for _, key := range c.requiredKeys() {
+		value := data[key]
+		if value == "" {
+			missing = append(missing, key)
+			continue
+		}
+		if re, found := c.patterns[key]; found && !re.MatchString(value) {
+			mismatched = append(mismatched, key)
+		}
+	}
+
+	if len(missing) > 0 || len(mismatched) > 0 {
+		var problems []string
+		if len(missing) > 0 {
+			problems = append(problems, fmt.Sprintf("missing or empty keys: %s", strings.Join(missing, ", ")))
+		}
+		if len(mismatched) > 0 {
+			problems = append(problems, fmt.Sprintf("keys not matching pattern: %s", strings.Join(mismatched, ", ")))
+		}
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("%s %s has %s", c.Config.Kind, c.Config.Name, strings.Join(problems, "; ")),
+		}, nil
+	}
+
+	return &WaitResult{
+		ConditionMet: true,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("%s %s has all %d required keys", c.Config.Kind, c.Config.Name, len(c.requiredKeys())),
+	}, nil
+}
+
+// requiredKeys returns the required, pattern and exposed keys in a stable order
+func (c *DataChecker) requiredKeys() []string {
+	keys := map[string]bool{}
+	for _, key := range c.Config.RequiredKeys {
+		keys[key] = true
+	}
+	for key := range c.Config.Patterns {
+		keys[key] = true
+	}
+	for _, key := range c.Config.ExposeKeys {
+		keys[key] = true
+	}
+
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// readData returns the data of the object as strings
+func (c *DataChecker) readData(ctx context.Context) (map[string]string, error) {
+	data := map[string]string{}
+
+	switch c.Config.Kind {
+	case "secret":
+		secret, err := c.Client.Clientset.CoreV1().Secrets(c.Config.Namespace).Get(ctx, c.Config.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range secret.Data {
+			data[key] = string(value)
+		}
+
+	case "configmap":
+		configMap, err := c.Client.Clientset.CoreV1().ConfigMaps(c.Config.Namespace).Get(ctx, c.Config.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for key, value := range configMap.BinaryData {
+			data[key] = string(value)
+		}
+		for key, value := range configMap.Data {
+			data[key] = value
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported kind: %s", c.Config.Kind)
+	}
+
+	return data, nil
+}
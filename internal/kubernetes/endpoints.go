@@ -0,0 +1,62 @@
+package kubernetes
+
+import (
+	"context"
+
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// readyEndpoint is a ready endpoint of a Service with the ports of its EndpointSlice
+type readyEndpoint struct {
+	discoveryv1.Endpoint
+	Ports []discoveryv1.EndpointPort
+}
+
+// port returns the port number of the named service port on the endpoint
+func (e readyEndpoint) port(name string) (int32, bool) {
+	for _, port := range e.Ports {
+		if port.Name != nil && *port.Name == name && port.Port != nil {
+			return *port.Port, true
+		}
+	}
+	return 0, false
+}
+
+// readyEndpoints returns the ready endpoints in the EndpointSlices of a Service
+func (c *Client) readyEndpoints(ctx context.Context, namespace, serviceName string) ([]readyEndpoint, error) {
+	sliceList, err := c.Clientset.DiscoveryV1().EndpointSlices(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: discoveryv1.LabelServiceName + "=" + serviceName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := []readyEndpoint{}
+	for _, slice := range sliceList.Items {
+		for _, endpoint := range slice.Endpoints {
+			// A nil ready condition should be interpreted as ready
+			if endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+				continue
+			}
+			endpoints = append(endpoints, readyEndpoint{Endpoint: endpoint, Ports: slice.Ports})
+		}
+	}
+
+	return endpoints, nil
+}
+
+// readyEndpointAddresses returns the ready addresses in the EndpointSlices of a Service
+func (c *Client) readyEndpointAddresses(ctx context.Context, namespace, serviceName string) ([]string, error) {
+	endpoints, err := c.readyEndpoints(ctx, namespace, serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []string{}
+	for _, endpoint := range endpoints {
+		addresses = append(addresses, endpoint.Addresses...)
+	}
+
+	return addresses, nil
+}
@@ -0,0 +1,216 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+)
+
+// EventsConfig holds the configuration for waiting on Kubernetes Events
+type EventsConfig struct {
+	Namespace     string        // Namespace of the events
+	Kind          string        // Kind of the involved object, empty for any
+	Name          string        // Name of the involved object, empty for any
+	Reason        string        // Reason of the event, empty for any
+	Type          string        // Type of the event ("Normal" or "Warning"), empty for any
+	Message       string        // Regular expression the event message must match
+	FailOn        bool          // Whether matching Warning events fail the wait
+	FailOnReason  string        // Reason of failing Warning events, empty for any
+	FailOnMessage string        // Regular expression failing Warning event messages must match
+	Timeout       time.Duration // Maximum wait time
+	CheckInterval time.Duration // Interval between checks
+}
+
+// EventsResult holds the result of an events wait
+type EventsResult struct {
+	WaitResult
+	EventMessage string // Message of the matching event
+}
+
+// EventsChecker waits for Events created after the wait started
+type EventsChecker struct {
+	Client *Client
+	Config *EventsConfig
+
+	started        time.Time
+	messagePattern *regexp.Regexp
+	failOnPattern  *regexp.Regexp
+	matched        *corev1.Event
+	failed         bool
+	listed         bool
+	listErr        error
+}
+
+// WaitForEvents waits until a matching event is seen. Without any of Reason,
+// Type or Message the wait only guards against failing Warning events, and
+// succeeds when none is seen before the timeout.
+func (c *EventsChecker) WaitForEvents(ctx context.Context) (*EventsResult, error) {
+	// Event timestamps have second precision
+	c.started = time.Now().Truncate(time.Second)
+
+	var err error
+	if c.Config.Message != "" {
+		if c.messagePattern, err = regexp.Compile(c.Config.Message); err != nil {
+			return nil, fmt.Errorf("invalid message pattern: %w", err)
+		}
+	}
+	if c.Config.FailOnMessage != "" {
+		if c.failOnPattern, err = regexp.Compile(c.Config.FailOnMessage); err != nil {
+			return nil, fmt.Errorf("invalid fail_on message pattern: %w", err)
+		}
+	}
+
+	description := fmt.Sprintf("events in namespace %s", c.Config.Namespace)
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, description, c.CheckEvents)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	// A guard succeeds when events could be listed until the timeout without a failing one
+	if err != nil && c.guardOnly() && !c.failed && c.listed && c.listErr == nil && ctx.Err() == nil {
+		result = &WaitResult{
+			ConditionMet: true,
+			LastChecked:  time.Now(),
+			Message:      fmt.Sprintf("No failing Warning events %s within %v", c.describeInvolvedObject(), c.Config.Timeout),
+		}
+		err = nil
+	}
+
+	eventsResult := &EventsResult{WaitResult: *result}
+	if c.matched != nil {
+		eventsResult.EventMessage = c.matched.Message
+	}
+	return eventsResult, err
+}
+
+// CheckEvents performs a single check of the events created since the wait started
+func (c *EventsChecker) CheckEvents(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	listOptions := metav1.ListOptions{}
+	selector := fields.Set{}
+	if c.Config.Kind != "" {
+		selector["involvedObject.kind"] = c.Config.Kind
+	}
+	if c.Config.Name != "" {
+		selector["involvedObject.name"] = c.Config.Name
+	}
+	if len(selector) > 0 {
+		listOptions.FieldSelector = selector.AsSelector().String()
+	}
+
+	eventList, err := c.Client.Clientset.CoreV1().Events(c.Config.Namespace).List(ctx, listOptions)
+	c.listErr = err
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to list events: %s", err),
+		}, err
+	}
+	c.listed = true
+
+	for i := range eventList.Items {
+		event := &eventList.Items[i]
+		if eventObservedAt(event).Before(c.started) {
+			continue
+		}
+
+		if c.isFailure(event) {
+			c.failed = true
+			message := fmt.Sprintf("Warning event %s on %s %s: %s", event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message)
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      message,
+			}, errors.New(message)
+		}
+
+		if !c.guardOnly() && c.isMatch(event) {
+			c.matched = event
+			return &WaitResult{
+				ConditionMet: true,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("%s event %s on %s %s: %s", event.Type, event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message),
+			}, nil
+		}
+	}
+
+	if c.guardOnly() {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("No failing Warning events %s", c.describeInvolvedObject()),
+		}, nil
+	}
+	return &WaitResult{
+		ConditionMet: false,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("No matching event %s since %s", c.describeInvolvedObject(), c.started.Format(time.RFC3339)),
+	}, nil
+}
+
+// guardOnly reports whether the wait only guards against failing events
+func (c *EventsChecker) guardOnly() bool {
+	return c.Config.FailOn && c.Config.Reason == "" && c.Config.Type == "" && c.Config.Message == ""
+}
+
+// isMatch reports whether an event matches the reason, type and message of the wait
+func (c *EventsChecker) isMatch(event *corev1.Event) bool {
+	if c.Config.Reason != "" && event.Reason != c.Config.Reason {
+		return false
+	}
+	if c.Config.Type != "" && event.Type != c.Config.Type {
+		return false
+	}
+	return c.messagePattern == nil || c.messagePattern.MatchString(event.Message)
+}
+
+// isFailure reports whether an event is a Warning event that fails the wait
+func (c *EventsChecker) isFailure(event *corev1.Event) bool {
+	if !c.Config.FailOn || event.Type != corev1.EventTypeWarning {
+		return false
+	}
+	if c.Config.FailOnReason != "" && event.Reason != c.Config.FailOnReason {
+		return false
+	}
+	return c.failOnPattern == nil || c.failOnPattern.MatchString(event.Message)
+}
+
+// describeInvolvedObject describes the involved object filter for messages
+func (c *EventsChecker) describeInvolvedObject() string {
+	var parts []string
+	if c.Config.Kind != "" {
+		parts = append(parts, c.Config.Kind)
+	}
+	if c.Config.Name != "" {
+		parts = append(parts, c.Config.Name)
+	}
+	if len(parts) == 0 {
+		return fmt.Sprintf("in namespace %s", c.Config.Namespace)
+	}
+	return fmt.Sprintf("for %s in namespace %s", strings.Join(parts, " "), c.Config.Namespace)
+}
+
+// eventObservedAt returns when an event was last observed. Repeated events are
+// aggregated into one object, so the latest occurrence is used.
+func eventObservedAt(event *corev1.Event) time.Time {
+	observed := event.CreationTimestamp.Time
+	if event.LastTimestamp.After(observed) {
+		observed = event.LastTimestamp.Time
+	}
+	if event.EventTime.After(observed) {
+		observed = event.EventTime.Time
+	}
+	if event.Series != nil && event.Series.LastObservedTime.After(observed) {
+		observed = event.Series.LastObservedTime.Time
+	}
+	return observed
+}
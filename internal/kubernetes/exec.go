@@ -0,0 +1,285 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/client-go/util/exec"
+)
+
+// maxReportedOutput is the number of trailing bytes of command output included in messages
+const maxReportedOutput = 2048
+
+// ExecConfig holds the configuration for waiting on a command inside a container
+type ExecConfig struct {
+	Namespace      string        // Namespace of the pod
+	Name           string        // Name of the pod, or empty to use Labels
+	Labels         string        // Label selector of the pods to choose from
+	Container      string        // Container to run the command in, or empty for the default container
+	Command        []string      // Command and arguments
+	StdoutPattern  string        // Regular expression stdout must match
+	Timeout        time.Duration // Maximum wait time
+	CheckInterval  time.Duration // Interval between checks
+	CommandTimeout time.Duration // Maximum time of a single run, defaults to CheckInterval
+}
+
+// ExecResult holds the result of an exec wait
+type ExecResult struct {
+	WaitResult
+	Pod      string // Pod the command last ran in
+	ExitCode int    // Exit code of the last run
+	Stdout   string // Stdout of the last run
+	Stderr   string // Stderr of the last run
+}
+
+// ExecChecker runs a command in a container until it succeeds
+type ExecChecker struct {
+	Client *Client
+	Config *ExecConfig
+
+	stdoutPattern *regexp.Regexp
+	deadline      time.Time
+	pod           string
+	exitCode      int
+	stdout        string
+	stderr        string
+}
+
+// WaitForExec runs the command every interval until it exits with 0 and its stdout matches the pattern
+func (c *ExecChecker) WaitForExec(ctx context.Context) (*ExecResult, error) {
+	if len(c.Config.Command) == 0 {
+		return nil, fmt.Errorf("command must not be empty")
+	}
+	if c.Config.StdoutPattern != "" {
+		var err error
+		if c.stdoutPattern, err = regexp.Compile(c.Config.StdoutPattern); err != nil {
+			return nil, fmt.Errorf("invalid stdout pattern: %w", err)
+		}
+	}
+
+	c.deadline = time.Now().Add(c.Config.Timeout)
+
+	description := fmt.Sprintf("command %q in %s", strings.Join(c.Config.Command, " "), c.describePods())
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, description, c.CheckExec)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	return &ExecResult{
+		WaitResult: *result,
+		Pod:        c.pod,
+		ExitCode:   c.exitCode,
+		Stdout:     c.stdout,
+		Stderr:     c.stderr,
+	}, err
+}
+
+// CheckExec runs the command once in a running pod
+func (c *ExecChecker) CheckExec(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	pod, err := c.selectPod(ctx)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to get pods: %s", err),
+		}, err
+	}
+	if pod == nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("No running pod found for %s", c.describePods()),
+		}, nil
+	}
+
+	container := c.Config.Container
+	if container == "" {
+		container = defaultContainerName(pod)
+	}
+
+	var stdout, stderr bytes.Buffer
+	err = c.exec(ctx, pod.Name, container, &stdout, &stderr)
+	c.pod = pod.Name
+	c.stdout = stdout.String()
+	c.stderr = stderr.String()
+	c.exitCode = 0
+
+	var exitErr exec.ExitError
+	switch {
+	case errors.As(err, &exitErr):
+		c.exitCode = exitErr.ExitStatus()
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Command exited with code %d in pod %s%s", c.exitCode, pod.Name, c.describeOutput()),
+		}, nil
+
+	case apierrors.IsForbidden(err):
+		message := fmt.Sprintf("Not allowed to exec into pod %s: %s", pod.Name, err)
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      message,
+		}, errors.New(message)
+
+	case err != nil:
+		// The container may be restarting, or the command may not exist yet
+		c.exitCode = -1
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to run command in pod %s: %s%s", pod.Name, err, c.describeOutput()),
+		}, nil
+	}
+
+	if c.stdoutPattern != nil && !c.stdoutPattern.MatchString(c.stdout) {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Command output in pod %s does not match %q%s", pod.Name, c.Config.StdoutPattern, c.describeOutput()),
+		}, nil
+	}
+
+	return &WaitResult{
+		ConditionMet: true,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("Command succeeded in pod %s", pod.Name),
+	}, nil
+}
+
+// exec runs the command through the pods/exec subresource
+func (c *ExecChecker) exec(ctx context.Context, pod, container string, stdout, stderr *bytes.Buffer) error {
+	request := c.Client.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(c.Config.Namespace).
+		Name(pod).
+		SubResource("exec").
+		VersionedParams(&corev1.PodExecOptions{
+			Container: container,
+			Command:   c.Config.Command,
+			Stdout:    true,
+			Stderr:    true,
+		}, scheme.ParameterCodec)
+
+	// client-go v0.28 only streams exec over SPDY, its WebSocket executor
+	// and the fallback between both were added in v0.29
+	executor, err := remotecommand.NewSPDYExecutor(c.Client.Config, "POST", request.URL())
+	if err != nil {
+		return err
+	}
+
+	// A hanging command is stopped so that it runs again at the next
+	// interval, and never outlives the timeout of the wait
+	timeout := c.Config.CommandTimeout
+	if timeout <= 0 {
+		timeout = c.Config.CheckInterval
+	}
+	deadline := c.deadline
+	if runDeadline := time.Now().Add(timeout); timeout > 0 && runDeadline.Before(deadline) {
+		deadline = runDeadline
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	err = executor.StreamWithContext(ctx, remotecommand.StreamOptions{
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) && deadline.Before(c.deadline) {
+		return fmt.Errorf("command did not finish within %s", timeout)
+	}
+	return err
+}
+
+// selectPod returns the named pod, or the first running pod matching the label selector.
+// It returns nil while no pod is running.
+func (c *ExecChecker) selectPod(ctx context.Context) (*corev1.Pod, error) {
+	var pods []corev1.Pod
+	if c.Config.Name != "" {
+		pod, err := c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).Get(ctx, c.Config.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		pods = []corev1.Pod{*pod}
+	} else {
+		podList, err := c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: c.Config.Labels,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pods = podList.Items
+	}
+
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		container := c.Config.Container
+		if container == "" {
+			container = defaultContainerName(pod)
+		}
+		if status, found := findContainerStatus(pod, container); found && status.State.Running != nil {
+			return pod, nil
+		}
+	}
+	return nil, nil
+}
+
+// describePods describes the pods the command runs in
+func (c *ExecChecker) describePods() string {
+	if c.Config.Name != "" {
+		return fmt.Sprintf("pod %s/%s", c.Config.Namespace, c.Config.Name)
+	}
+	return fmt.Sprintf("pods %q in namespace %s", c.Config.Labels, c.Config.Namespace)
+}
+
+// describeOutput formats the tail of the output of the last run for messages
+func (c *ExecChecker) describeOutput() string {
+	var description string
+	if stdout := strings.TrimSpace(c.stdout); stdout != "" {
+		description += fmt.Sprintf("\nstdout: %s", tailOutput(stdout))
+	}
+	if stderr := strings.TrimSpace(c.stderr); stderr != "" {
+		description += fmt.Sprintf("\nstderr: %s", tailOutput(stderr))
+	}
+	return description
+}
+
+// defaultContainerName returns the container kubectl uses when none is given
+func defaultContainerName(pod *corev1.Pod) string {
+	if container := pod.Annotations[defaultContainerAnnotation]; container != "" {
+		return container
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
+
+// tailOutput returns the end of long command output
+func tailOutput(output string) string {
+	if len(output) <= maxReportedOutput {
+		return output
+	}
+	return "..." + output[len(output)-maxReportedOutput:]
+}
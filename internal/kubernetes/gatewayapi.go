@@ -0,0 +1,315 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// gatewayAPIGroup is the API group of the Gateway API resources
+const gatewayAPIGroup = "gateway.networking.k8s.io"
+
+// listGatewayAPIObjects lists Gateway API objects of the given resource using
+// the preferred version served by the cluster, filtered by name
+func (c *ConditionChecker) listGatewayAPIObjects(ctx context.Context, resource string) ([]unstructured.Unstructured, error) {
+	gvr, err := c.Client.preferredGroupVersionResource(gatewayAPIGroup, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	listOptions := metav1.ListOptions{}
+	if c.Config.Labels != "" {
+		listOptions.LabelSelector = c.Config.Labels
+	}
+	if c.Config.FieldSelector != "" {
+		listOptions.FieldSelector = c.Config.FieldSelector
+	}
+
+	objectList, err := c.Client.Dynamic.Resource(gvr).Namespace(c.Config.Namespace).List(ctx, listOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.Config.Name == "" {
+		return objectList.Items, nil
+	}
+
+	// Filter by specific name
+	filteredObjects := []unstructured.Unstructured{}
+	for _, obj := range objectList.Items {
+		if obj.GetName() == c.Config.Name {
+			filteredObjects = append(filteredObjects, obj)
+		}
+	}
+	return filteredObjects, nil
+}
+
+// checkGatewayCondition checks conditions on Gateway API gateways
+func (c *ConditionChecker) checkGatewayCondition(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error) {
+	now := time.Now()
+
+	gateways, err := c.listGatewayAPIObjects(ctx, "gateways")
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to list gateways: %s", err),
+		}, err
+	}
+
+	if len(gateways) == 0 {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      "No matching gateways found",
+		}, nil
+	}
+
+	readyGateways := 0
+	totalGateways := len(gateways)
+	ips := []string{}
+	hostnames := []string{}
+
+	for _, gateway := range gateways {
+		addresses, _, _ := unstructured.NestedSlice(gateway.Object, "status", "addresses")
+		for _, rawAddress := range addresses {
+			address, ok := rawAddress.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			addressType, _, _ := unstructured.NestedString(address, "type")
+			value, _, _ := unstructured.NestedString(address, "value")
+			if addressType == "Hostname" {
+				hostnames = append(hostnames, value)
+			} else if value != "" {
+				ips = append(ips, value)
+			}
+		}
+
+		switch conditionType {
+		case "condition":
+			// Conditions observed for an older generation are stale
+			condition, found := findUnstructuredCondition(&gateway, conditionValue)
+			if found && condition.Status == "True" && condition.isCurrent(gateway.GetGeneration()) {
+				readyGateways++
+			}
+		case "listeners":
+			if gatewayListenersProgrammed(&gateway) {
+				readyGateways++
+			}
+		}
+	}
+
+	conditionMet := false
+	var message string
+
+	if c.Config.All {
+		conditionMet = readyGateways == totalGateways
+		message = fmt.Sprintf("%d/%d gateways meet condition %s", readyGateways, totalGateways, c.Config.Condition)
+	} else {
+		conditionMet = readyGateways > 0
+		message = fmt.Sprintf("%d/%d gateways meet condition %s", readyGateways, totalGateways, c.Config.Condition)
+	}
+
+	return &WaitResult{
+		ConditionMet: conditionMet,
+		LastChecked:  now,
+		Message:      message,
+		IPs:          ips,
+		Hostnames:    hostnames,
+	}, nil
+}
+
+// gatewayListenersProgrammed reports whether every listener of a gateway is Programmed
+func gatewayListenersProgrammed(gateway *unstructured.Unstructured) bool {
+	listeners, _, _ := unstructured.NestedSlice(gateway.Object, "status", "listeners")
+	specListeners, _, _ := unstructured.NestedSlice(gateway.Object, "spec", "listeners")
+	if len(listeners) == 0 || len(listeners) < len(specListeners) {
+		return false
+	}
+
+	for _, rawListener := range listeners {
+		listener, ok := rawListener.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		programmed := false
+		for _, condition := range getUnstructuredConditions(listener, "conditions") {
+			if condition.Type == "Programmed" && condition.Status == "True" {
+				programmed = true
+			}
+		}
+		if !programmed {
+			return false
+		}
+	}
+
+	return true
+}
+
+// checkRouteCondition returns a checker for Gateway API routes (httproutes,
+// grpcroutes, ...) where conditions are reported per parent in status.parents
+func (c *ConditionChecker) checkRouteCondition(resource string) conditionCheckFunc {
+	return func(ctx context.Context, conditionType, conditionValue string) (*WaitResult, error) {
+		now := time.Now()
+
+		routes, err := c.listGatewayAPIObjects(ctx, resource)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to list %s: %s", resource, err),
+			}, err
+		}
+
+		if len(routes) == 0 {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("No matching %s found", resource),
+			}, nil
+		}
+
+		readyRoutes := 0
+		totalRoutes := len(routes)
+		problems := []string{}
+
+		for _, route := range routes {
+			if conditionType != "condition" {
+				continue
+			}
+
+			pending := routeParentsWithoutCondition(&route, conditionValue)
+			if len(pending) == 0 {
+				readyRoutes++
+			} else {
+				problems = append(problems, fmt.Sprintf("%s: not %s by %s", route.GetName(), conditionValue, strings.Join(pending, ", ")))
+			}
+		}
+
+		conditionMet := false
+		var message string
+
+		if c.Config.All {
+			conditionMet = readyRoutes == totalRoutes
+			message = fmt.Sprintf("%d/%d %s meet condition %s", readyRoutes, totalRoutes, resource, c.Config.Condition)
+		} else {
+			conditionMet = readyRoutes > 0
+			message = fmt.Sprintf("%d/%d %s meet condition %s", readyRoutes, totalRoutes, resource, c.Config.Condition)
+		}
+		if len(problems) > 0 {
+			message = fmt.Sprintf("%s (%s)", message, strings.Join(problems, "; "))
+		}
+
+		return &WaitResult{
+			ConditionMet: conditionMet,
+			LastChecked:  now,
+			Message:      message,
+		}, nil
+	}
+}
+
+// routeParentsWithoutCondition returns the parentRefs of a route whose entry in
+// status.parents does not report the condition as True for the current generation
+func routeParentsWithoutCondition(route *unstructured.Unstructured, conditionType string) []string {
+	parentRefs, _, _ := unstructured.NestedSlice(route.Object, "spec", "parentRefs")
+	parentStatuses, _, _ := unstructured.NestedSlice(route.Object, "status", "parents")
+
+	if len(parentRefs) == 0 {
+		return []string{"any parent (no parentRefs)"}
+	}
+
+	pending := []string{}
+	for _, rawParentRef := range parentRefs {
+		parentRef, ok := rawParentRef.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key := routeParentKey(parentRef, route.GetNamespace())
+
+		met := false
+		for _, rawParentStatus := range parentStatuses {
+			parentStatus, ok := rawParentStatus.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			statusRef, _, _ := unstructured.NestedMap(parentStatus, "parentRef")
+			if routeParentKey(statusRef, route.GetNamespace()) != key {
+				continue
+			}
+
+			for _, condition := range getUnstructuredConditions(parentStatus, "conditions") {
+				if condition.Type == conditionType && condition.Status == "True" && condition.isCurrent(route.GetGeneration()) {
+					met = true
+				}
+			}
+		}
+
+		if !met {
+			pending = append(pending, key)
+		}
+	}
+
+	return pending
+}
+
+// routeParentKey identifies a route parent reference as "namespace/name[/sectionName]"
+func routeParentKey(parentRef map[string]interface{}, defaultNamespace string) string {
+	name, _, _ := unstructured.NestedString(parentRef, "name")
+	namespace, _, _ := unstructured.NestedString(parentRef, "namespace")
+	sectionName, _, _ := unstructured.NestedString(parentRef, "sectionName")
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	key := namespace + "/" + name
+	if sectionName != "" {
+		key = key + "/" + sectionName
+	}
+	return key
+}
+
+// preferredGroupVersionResource returns the resource in the preferred version
+// of an API group served by the cluster, falling back to other served versions
+// when the preferred version does not serve the resource
+func (c *Client) preferredGroupVersionResource(group, resource string) (schema.GroupVersionResource, error) {
+	groups, err := c.Clientset.Discovery().ServerGroups()
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+
+	for _, apiGroup := range groups.Groups {
+		if apiGroup.Name != group {
+			continue
+		}
+
+		versions := []string{apiGroup.PreferredVersion.Version}
+		for _, groupVersion := range apiGroup.Versions {
+			if groupVersion.Version != apiGroup.PreferredVersion.Version {
+				versions = append(versions, groupVersion.Version)
+			}
+		}
+
+		for _, version := range versions {
+			resourceList, err := c.Clientset.Discovery().ServerResourcesForGroupVersion(group + "/" + version)
+			if err != nil {
+				return schema.GroupVersionResource{}, err
+			}
+			for _, apiResource := range resourceList.APIResources {
+				if apiResource.Name == resource {
+					return schema.GroupVersionResource{Group: group, Version: version, Resource: resource}, nil
+				}
+			}
+		}
+
+		return schema.GroupVersionResource{}, fmt.Errorf("resource %s is not served by API group %s", resource, group)
+	}
+
+	return schema.GroupVersionResource{}, fmt.Errorf("API group %s is not served by the cluster", group)
+}
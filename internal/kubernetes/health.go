@@ -0,0 +1,202 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// healthRule assesses the health of an object of a known kind, returning
+// whether it is healthy and a description of its state
+type healthRule func(obj *unstructured.Unstructured) (bool, string)
+
+// healthRules holds the built-in health assessments for popular CRDs, keyed by group and kind
+var healthRules = map[schema.GroupKind]healthRule{
+	// cert-manager
+	{Group: "cert-manager.io", Kind: "Certificate"}:   readyConditionHealth,
+	{Group: "cert-manager.io", Kind: "Issuer"}:        readyConditionHealth,
+	{Group: "cert-manager.io", Kind: "ClusterIssuer"}: readyConditionHealth,
+
+	// Flux
+	{Group: "kustomize.toolkit.fluxcd.io", Kind: "Kustomization"}: fluxHealth,
+	{Group: "helm.toolkit.fluxcd.io", Kind: "HelmRelease"}:        fluxHealth,
+	{Group: "source.toolkit.fluxcd.io", Kind: "GitRepository"}:    fluxHealth,
+	{Group: "source.toolkit.fluxcd.io", Kind: "OCIRepository"}:    fluxHealth,
+	{Group: "source.toolkit.fluxcd.io", Kind: "HelmRepository"}:   fluxHealth,
+	{Group: "source.toolkit.fluxcd.io", Kind: "HelmChart"}:        fluxHealth,
+	{Group: "source.toolkit.fluxcd.io", Kind: "Bucket"}:           fluxHealth,
+
+	// Argo CD and Argo Rollouts
+	{Group: "argoproj.io", Kind: "Application"}: argoCDApplicationHealth,
+	{Group: "argoproj.io", Kind: "Rollout"}:     argoRolloutHealth,
+
+	// Cluster API
+	{Group: "cluster.x-k8s.io", Kind: "Cluster"}:           clusterAPIClusterHealth,
+	{Group: "cluster.x-k8s.io", Kind: "MachineDeployment"}: clusterAPIMachineDeploymentHealth,
+
+	// Knative
+	{Group: "serving.knative.dev", Kind: "Service"}: readyConditionHealth,
+
+	// Operator Lifecycle Manager
+	{Group: "operators.coreos.com", Kind: "ClusterServiceVersion"}: clusterServiceVersionHealth,
+}
+
+// findHealthRule returns the built-in health rule for an object. Crossplane
+// managed resources and composites live in many groups, so they are also
+// recognized by their spec instead of their group.
+func findHealthRule(groupKind schema.GroupKind, obj *unstructured.Unstructured) (healthRule, bool) {
+	if rule, found := healthRules[groupKind]; found {
+		return rule, true
+	}
+
+	if strings.HasSuffix(groupKind.Group, ".crossplane.io") || strings.HasSuffix(groupKind.Group, ".upbound.io") {
+		// Packages report Healthy and Installed, XRDs and Compositions
+		// Established and Offered, neither has Ready and Synced
+		if strings.HasPrefix(groupKind.Group, "pkg.") || strings.HasPrefix(groupKind.Group, "apiextensions.") {
+			return nil, false
+		}
+		return crossplaneHealth, true
+	}
+	for _, fields := range [][]string{{"spec", "forProvider"}, {"spec", "compositionRef"}, {"spec", "crossplane"}} {
+		if _, found, _ := unstructured.NestedMap(obj.Object, fields...); found {
+			return crossplaneHealth, true
+		}
+	}
+
+	return nil, false
+}
+
+// readyConditionHealth requires the Ready condition to be True for the current generation
+func readyConditionHealth(obj *unstructured.Unstructured) (bool, string) {
+	if !observedGenerationCurrent(obj) {
+		return false, fmt.Sprintf("generation %d not reconciled yet", obj.GetGeneration())
+	}
+	return conditionsHealth(obj, "Ready")
+}
+
+// conditionsHealth requires every given condition to be True for the current generation
+func conditionsHealth(obj *unstructured.Unstructured, conditionTypes ...string) (bool, string) {
+	for _, conditionType := range conditionTypes {
+		condition, found := findUnstructuredCondition(obj, conditionType)
+		if !found {
+			return false, fmt.Sprintf("no %s condition", conditionType)
+		}
+		if !condition.isCurrent(obj.GetGeneration()) {
+			return false, fmt.Sprintf("%s condition not observed for generation %d", conditionType, obj.GetGeneration())
+		}
+		if condition.Status != "True" {
+			return false, describeCondition(condition)
+		}
+	}
+	return true, strings.Join(conditionTypes, ", ")
+}
+
+// describeCondition formats a condition like "Ready=False (Reason): message"
+func describeCondition(condition unstructuredCondition) string {
+	description := fmt.Sprintf("%s=%s", condition.Type, condition.Status)
+	if condition.Reason != "" {
+		description = fmt.Sprintf("%s (%s)", description, condition.Reason)
+	}
+	if condition.Message != "" {
+		description = fmt.Sprintf("%s: %s", description, condition.Message)
+	}
+	return description
+}
+
+// observedGenerationCurrent reports whether status.observedGeneration has caught up with the object generation
+func observedGenerationCurrent(obj *unstructured.Unstructured) bool {
+	observedGeneration, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	return !found || observedGeneration >= obj.GetGeneration()
+}
+
+// fluxHealth assesses Flux objects, which are not reconciled while suspended
+func fluxHealth(obj *unstructured.Unstructured) (bool, string) {
+	if suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend"); suspended {
+		return false, "suspended"
+	}
+	return readyConditionHealth(obj)
+}
+
+// argoCDApplicationHealth requires an Argo CD Application to be Healthy and Synced
+func argoCDApplicationHealth(obj *unstructured.Unstructured) (bool, string) {
+	health, _, _ := unstructured.NestedString(obj.Object, "status", "health", "status")
+	sync, _, _ := unstructured.NestedString(obj.Object, "status", "sync", "status")
+	description := fmt.Sprintf("health %s, sync %s", valueOrUnknown(health), valueOrUnknown(sync))
+
+	if phase, _, _ := unstructured.NestedString(obj.Object, "status", "operationState", "phase"); phase == "Failed" || phase == "Error" {
+		message, _, _ := unstructured.NestedString(obj.Object, "status", "operationState", "message")
+		description = fmt.Sprintf("%s, operation %s: %s", description, phase, message)
+	}
+
+	return health == "Healthy" && sync == "Synced", description
+}
+
+// argoRolloutHealth requires an Argo Rollout to report the Healthy phase
+func argoRolloutHealth(obj *unstructured.Unstructured) (bool, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+
+	description := fmt.Sprintf("phase %s", valueOrUnknown(phase))
+	if message != "" {
+		description = fmt.Sprintf("%s: %s", description, message)
+	}
+	return phase == "Healthy", description
+}
+
+// clusterAPIClusterHealth requires a Cluster API Cluster to be Provisioned and Ready
+func clusterAPIClusterHealth(obj *unstructured.Unstructured) (bool, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase != "Provisioned" {
+		return false, fmt.Sprintf("phase %s", valueOrUnknown(phase))
+	}
+	return readyConditionHealth(obj)
+}
+
+// clusterAPIMachineDeploymentHealth requires every replica of a MachineDeployment to be updated and ready
+func clusterAPIMachineDeploymentHealth(obj *unstructured.Unstructured) (bool, string) {
+	if !observedGenerationCurrent(obj) {
+		return false, fmt.Sprintf("generation %d not reconciled yet", obj.GetGeneration())
+	}
+
+	replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas")
+	if !found {
+		replicas = 1
+	}
+	updatedReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "updatedReplicas")
+	readyReplicas, _, _ := unstructured.NestedInt64(obj.Object, "status", "readyReplicas")
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+
+	description := fmt.Sprintf("phase %s, %d/%d updated, %d/%d ready", valueOrUnknown(phase), updatedReplicas, replicas, readyReplicas, replicas)
+	return updatedReplicas >= replicas && readyReplicas >= replicas, description
+}
+
+// clusterServiceVersionHealth requires an OLM ClusterServiceVersion to reach the Succeeded phase
+func clusterServiceVersionHealth(obj *unstructured.Unstructured) (bool, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	reason, _, _ := unstructured.NestedString(obj.Object, "status", "reason")
+	message, _, _ := unstructured.NestedString(obj.Object, "status", "message")
+
+	description := fmt.Sprintf("phase %s", valueOrUnknown(phase))
+	if reason != "" {
+		description = fmt.Sprintf("%s (%s)", description, reason)
+	}
+	if message != "" {
+		description = fmt.Sprintf("%s: %s", description, message)
+	}
+	return phase == "Succeeded", description
+}
+
+// crossplaneHealth requires Crossplane resources to be both Ready and Synced
+func crossplaneHealth(obj *unstructured.Unstructured) (bool, string) {
+	return conditionsHealth(obj, "Ready", "Synced")
+}
+
+// valueOrUnknown returns "Unknown" for empty status values
+func valueOrUnknown(value string) string {
+	if value == "" {
+		return "Unknown"
+	}
+	return value
+}
@@ -0,0 +1,187 @@
+package kubernetes
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func healthTestObject(apiVersion, kind string, spec, status map[string]interface{}) *unstructured.Unstructured {
+	obj := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": "test", "generation": int64(1)},
+	}
+	if spec != nil {
+		obj["spec"] = spec
+	}
+	if status != nil {
+		obj["status"] = status
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func conditionsStatusField(conditions ...map[string]interface{}) map[string]interface{} {
+	list := []interface{}{}
+	for _, condition := range conditions {
+		list = append(list, condition)
+	}
+	return map[string]interface{}{"conditions": list}
+}
+
+func TestFindHealthRule(t *testing.T) {
+	tests := []struct {
+		name      string
+		groupKind schema.GroupKind
+		spec      map[string]interface{}
+		want      healthRule
+	}{
+		{name: "cert-manager certificate", groupKind: schema.GroupKind{Group: "cert-manager.io", Kind: "Certificate"}, want: readyConditionHealth},
+		{name: "flux kustomization", groupKind: schema.GroupKind{Group: "kustomize.toolkit.fluxcd.io", Kind: "Kustomization"}, want: fluxHealth},
+		{name: "argo cd application", groupKind: schema.GroupKind{Group: "argoproj.io", Kind: "Application"}, want: argoCDApplicationHealth},
+		{name: "argo rollout", groupKind: schema.GroupKind{Group: "argoproj.io", Kind: "Rollout"}, want: argoRolloutHealth},
+		{name: "cluster api cluster", groupKind: schema.GroupKind{Group: "cluster.x-k8s.io", Kind: "Cluster"}, want: clusterAPIClusterHealth},
+		{name: "olm cluster service version", groupKind: schema.GroupKind{Group: "operators.coreos.com", Kind: "ClusterServiceVersion"}, want: clusterServiceVersionHealth},
+		{name: "crossplane provider group", groupKind: schema.GroupKind{Group: "s3.aws.upbound.io", Kind: "Bucket"}, want: crossplaneHealth},
+		{
+			name:      "managed resource recognized by forProvider",
+			groupKind: schema.GroupKind{Group: "database.example.org", Kind: "Instance"},
+			spec:      map[string]interface{}{"forProvider": map[string]interface{}{"region": "eu-west-1"}},
+			want:      crossplaneHealth,
+		},
+		{
+			name:      "composite recognized by compositionRef",
+			groupKind: schema.GroupKind{Group: "platform.example.org", Kind: "XDatabase"},
+			spec:      map[string]interface{}{"compositionRef": map[string]interface{}{"name": "aws"}},
+			want:      crossplaneHealth,
+		},
+		{
+			name:      "composite recognized by spec.crossplane",
+			groupKind: schema.GroupKind{Group: "platform.example.org", Kind: "Database"},
+			spec:      map[string]interface{}{"crossplane": map[string]interface{}{"compositionRef": map[string]interface{}{"name": "aws"}}},
+			want:      crossplaneHealth,
+		},
+		{name: "crossplane provider package", groupKind: schema.GroupKind{Group: "pkg.crossplane.io", Kind: "Provider"}, want: nil},
+		{name: "crossplane configuration package", groupKind: schema.GroupKind{Group: "pkg.crossplane.io", Kind: "Configuration"}, want: nil},
+		{name: "crossplane composite resource definition", groupKind: schema.GroupKind{Group: "apiextensions.crossplane.io", Kind: "CompositeResourceDefinition"}, want: nil},
+		{name: "crossplane composition", groupKind: schema.GroupKind{Group: "apiextensions.crossplane.io", Kind: "Composition"}, want: nil},
+		{name: "kind of another group", groupKind: schema.GroupKind{Group: "example.com", Kind: "Application"}, want: nil},
+		{name: "unknown kind", groupKind: schema.GroupKind{Group: "example.com", Kind: "Widget"}, want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := healthTestObject(tt.groupKind.Group+"/v1", tt.groupKind.Kind, tt.spec, nil)
+			rule, found := findHealthRule(tt.groupKind, obj)
+			if tt.want == nil {
+				if found {
+					t.Errorf("findHealthRule(%s) found a rule, want none", tt.groupKind)
+				}
+				return
+			}
+			if !found || reflect.ValueOf(rule).Pointer() != reflect.ValueOf(tt.want).Pointer() {
+				t.Errorf("findHealthRule(%s) returned the wrong rule", tt.groupKind)
+			}
+		})
+	}
+}
+
+func TestHealthRules(t *testing.T) {
+	ready := map[string]interface{}{"type": "Ready", "status": "True"}
+	notReady := map[string]interface{}{"type": "Ready", "status": "False", "reason": "Progressing"}
+	synced := map[string]interface{}{"type": "Synced", "status": "True"}
+
+	tests := []struct {
+		name string
+		rule healthRule
+		obj  *unstructured.Unstructured
+		want bool
+	}{
+		{
+			name: "ready condition true",
+			rule: readyConditionHealth,
+			obj:  healthTestObject("cert-manager.io/v1", "Certificate", nil, conditionsStatusField(ready)),
+			want: true,
+		},
+		{
+			name: "ready condition false",
+			rule: readyConditionHealth,
+			obj:  healthTestObject("cert-manager.io/v1", "Certificate", nil, conditionsStatusField(notReady)),
+			want: false,
+		},
+		{
+			name: "ready condition missing",
+			rule: readyConditionHealth,
+			obj:  healthTestObject("cert-manager.io/v1", "Certificate", nil, nil),
+			want: false,
+		},
+		{
+			name: "flux object suspended",
+			rule: fluxHealth,
+			obj:  healthTestObject("kustomize.toolkit.fluxcd.io/v1", "Kustomization", map[string]interface{}{"suspend": true}, conditionsStatusField(ready)),
+			want: false,
+		},
+		{
+			name: "argo cd application healthy and synced",
+			rule: argoCDApplicationHealth,
+			obj: healthTestObject("argoproj.io/v1alpha1", "Application", nil, map[string]interface{}{
+				"health": map[string]interface{}{"status": "Healthy"},
+				"sync":   map[string]interface{}{"status": "Synced"},
+			}),
+			want: true,
+		},
+		{
+			name: "argo cd application out of sync",
+			rule: argoCDApplicationHealth,
+			obj: healthTestObject("argoproj.io/v1alpha1", "Application", nil, map[string]interface{}{
+				"health": map[string]interface{}{"status": "Healthy"},
+				"sync":   map[string]interface{}{"status": "OutOfSync"},
+			}),
+			want: false,
+		},
+		{
+			name: "argo rollout degraded",
+			rule: argoRolloutHealth,
+			obj:  healthTestObject("argoproj.io/v1alpha1", "Rollout", nil, map[string]interface{}{"phase": "Degraded"}),
+			want: false,
+		},
+		{
+			name: "cluster api machine deployment not all ready",
+			rule: clusterAPIMachineDeploymentHealth,
+			obj: healthTestObject("cluster.x-k8s.io/v1beta1", "MachineDeployment", map[string]interface{}{"replicas": int64(3)}, map[string]interface{}{
+				"updatedReplicas": int64(3),
+				"readyReplicas":   int64(2),
+			}),
+			want: false,
+		},
+		{
+			name: "cluster service version succeeded",
+			rule: clusterServiceVersionHealth,
+			obj:  healthTestObject("operators.coreos.com/v1alpha1", "ClusterServiceVersion", nil, map[string]interface{}{"phase": "Succeeded"}),
+			want: true,
+		},
+		{
+			name: "crossplane ready and synced",
+			rule: crossplaneHealth,
+			obj:  healthTestObject("s3.aws.upbound.io/v1beta1", "Bucket", nil, conditionsStatusField(ready, synced)),
+			want: true,
+		},
+		{
+			name: "crossplane ready but not synced",
+			rule: crossplaneHealth,
+			obj:  healthTestObject("s3.aws.upbound.io/v1beta1", "Bucket", nil, conditionsStatusField(ready)),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, description := tt.rule(tt.obj)
+			if got != tt.want {
+				t.Errorf("rule() = %t (%s), want %t", got, description, tt.want)
+			}
+		})
+	}
+}
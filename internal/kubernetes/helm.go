@@ -0,0 +1,198 @@
+package kubernetes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// HelmReleaseConfig holds the configuration for waiting on a Helm release
+type HelmReleaseConfig struct {
+	Name          string        // Release name
+	Namespace     string        // Namespace the release is stored in
+	Status        string        // Status to wait for (e.g., "deployed")
+	MinRevision   int           // Minimum release revision, 0 for any
+	Timeout       time.Duration // Maximum wait time
+	CheckInterval time.Duration // Interval between checks
+}
+
+// HelmReleaseResult holds the result of a Helm release wait
+type HelmReleaseResult struct {
+	WaitResult
+	Status       string // Status of the latest revision
+	Revision     int    // Latest revision
+	Chart        string // Chart name
+	ChartVersion string // Chart version
+	AppVersion   string // App version of the chart
+}
+
+// HelmReleaseChecker waits for a Helm release by reading the release Secrets
+// written by Helm's default storage driver
+type HelmReleaseChecker struct {
+	Client *Client
+	Config *HelmReleaseConfig
+
+	release *helmRelease
+}
+
+// helmRelease is the part of a Helm release record that is checked
+type helmRelease struct {
+	Name    string `json:"name"`
+	Version int    `json:"version"`
+	Info    struct {
+		Status      string `json:"status"`
+		Description string `json:"description"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Name       string `json:"name"`
+			Version    string `json:"version"`
+			AppVersion string `json:"appVersion"`
+		} `json:"metadata"`
+	} `json:"chart"`
+}
+
+// gzipMagic is the header of gzip compressed release records
+var gzipMagic = []byte{0x1f, 0x8b, 0x08}
+
+// WaitForHelmRelease waits until the latest revision of the release reaches the status
+func (c *HelmReleaseChecker) WaitForHelmRelease(ctx context.Context) (*HelmReleaseResult, error) {
+	description := fmt.Sprintf("helm release %s/%s %s", c.Config.Namespace, c.Config.Name, c.Config.Status)
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, description, c.CheckHelmRelease)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	helmReleaseResult := &HelmReleaseResult{WaitResult: *result}
+	if c.release != nil {
+		helmReleaseResult.Status = c.release.Info.Status
+		helmReleaseResult.Revision = c.release.Version
+		helmReleaseResult.Chart = c.release.Chart.Metadata.Name
+		helmReleaseResult.ChartVersion = c.release.Chart.Metadata.Version
+		helmReleaseResult.AppVersion = c.release.Chart.Metadata.AppVersion
+	}
+	return helmReleaseResult, err
+}
+
+// CheckHelmRelease performs a single check of the latest revision of the release
+func (c *HelmReleaseChecker) CheckHelmRelease(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	release, err := c.latestRelease(ctx)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to read helm release %s: %s", c.Config.Name, err),
+		}, err
+	}
+	if release == nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Helm release %s not found in namespace %s", c.Config.Name, c.Config.Namespace),
+		}, nil
+	}
+	c.release = release
+
+	message := fmt.Sprintf("Helm release %s revision %d is %s (chart %s-%s)",
+		release.Name, release.Version, release.Info.Status, release.Chart.Metadata.Name, release.Chart.Metadata.Version)
+
+	if release.Version < c.Config.MinRevision {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("%s, waiting for revision %d", message, c.Config.MinRevision),
+		}, nil
+	}
+
+	// A failed release will not reach the status without another install or upgrade
+	switch release.Info.Status {
+	case c.Config.Status:
+		return &WaitResult{
+			ConditionMet: true,
+			LastChecked:  now,
+			Message:      message,
+		}, nil
+	case "failed", "pending-rollback":
+		if release.Info.Description != "" {
+			message = fmt.Sprintf("%s: %s", message, release.Info.Description)
+		}
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      message,
+		}, errors.New(message)
+	}
+
+	return &WaitResult{
+		ConditionMet: false,
+		LastChecked:  now,
+		Message:      message,
+	}, nil
+}
+
+// latestRelease decodes the release Secret with the highest revision, or returns nil if there is none
+func (c *HelmReleaseChecker) latestRelease(ctx context.Context) (*helmRelease, error) {
+	secretList, err := c.Client.Clientset.CoreV1().Secrets(c.Config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("owner=helm,name=%s", c.Config.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *helmRelease
+	for _, secret := range secretList.Items {
+		if secret.Type != "helm.sh/release.v1" {
+			continue
+		}
+
+		release, err := decodeHelmRelease(secret.Data["release"])
+		if err != nil {
+			return nil, fmt.Errorf("decoding secret %s: %w", secret.Name, err)
+		}
+		if latest == nil || release.Version > latest.Version {
+			latest = release
+		}
+	}
+
+	return latest, nil
+}
+
+// decodeHelmRelease decodes a release record stored as base64 encoded, gzip compressed JSON
+func decodeHelmRelease(data []byte) (*helmRelease, error) {
+	decoded := make([]byte, base64.StdEncoding.DecodedLen(len(data)))
+	n, err := base64.StdEncoding.Decode(decoded, data)
+	if err != nil {
+		return nil, err
+	}
+	decoded = decoded[:n]
+
+	// Releases written by old Helm versions are not compressed
+	if bytes.HasPrefix(decoded, gzipMagic) {
+		reader, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+
+		decoded, err = io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	release := &helmRelease{}
+	if err := json.Unmarshal(decoded, release); err != nil {
+		return nil, err
+	}
+	return release, nil
+}
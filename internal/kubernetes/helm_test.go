@@ -0,0 +1,67 @@
+package kubernetes
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"testing"
+)
+
+const helmReleaseJSON = `{"name":"web","version":3,"info":{"status":"deployed","description":"Upgrade complete"},"chart":{"metadata":{"name":"nginx","version":"15.1.0","appVersion":"1.25.3"}}}`
+
+func gzipped(t *testing.T, data string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(data)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func encoded(data []byte) []byte {
+	return []byte(base64.StdEncoding.EncodeToString(data))
+}
+
+func TestDecodeHelmRelease(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    []byte
+		wantErr bool
+	}{
+		{name: "gzip compressed", data: encoded(gzipped(t, helmReleaseJSON))},
+		{name: "plain JSON from old Helm versions", data: encoded([]byte(helmReleaseJSON))},
+		{name: "not base64", data: []byte("not base64!"), wantErr: true},
+		{name: "not JSON", data: encoded([]byte("release")), wantErr: true},
+		{name: "truncated gzip", data: encoded(gzipped(t, helmReleaseJSON)[:12]), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			release, err := decodeHelmRelease(tt.data)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got release %+v", release)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if release.Name != "web" || release.Version != 3 {
+				t.Errorf("got release %s version %d, want web version 3", release.Name, release.Version)
+			}
+			if release.Info.Status != "deployed" {
+				t.Errorf("got status %q, want deployed", release.Info.Status)
+			}
+			if release.Chart.Metadata.Name != "nginx" || release.Chart.Metadata.Version != "15.1.0" || release.Chart.Metadata.AppVersion != "1.25.3" {
+				t.Errorf("got chart %+v, want nginx 15.1.0 (1.25.3)", release.Chart.Metadata)
+			}
+		})
+	}
+}
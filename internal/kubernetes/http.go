@@ -0,0 +1,306 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	// maxResponseSize is the largest response body that is read
+	maxResponseSize = 1024 * 1024
+	// httpRequestTimeout abandons a request that hangs, it is retried at the next check
+	httpRequestTimeout = 30 * time.Second
+)
+
+// HTTPConfig holds the configuration for waiting on an HTTP endpoint behind the API server proxy
+type HTTPConfig struct {
+	Namespace       string            // Namespace of the service or pod
+	Service         string            // Service to proxy to, or empty to use Pod
+	Pod             string            // Pod to proxy to
+	Port            string            // Port name or number, or empty for the default port
+	Scheme          string            // "http" or "https"
+	Method          string            // HTTP method
+	Path            string            // Request path, including the query
+	RequestHeaders  map[string]string // Headers sent with the request
+	RequestBody     string            // Body sent with the request
+	StatusCode      int               // Expected status code
+	BodyPattern     string            // Regular expression the body must match
+	JSONPath        string            // JSONPath expression evaluated against the body
+	JSONValue       string            // Expected value of the JSONPath expression, or empty for any
+	ResponseHeaders map[string]string // Regular expressions response headers must match
+	Timeout         time.Duration     // Maximum wait time
+	CheckInterval   time.Duration     // Interval between checks
+}
+
+// HTTPResult holds the result of an HTTP wait
+type HTTPResult struct {
+	WaitResult
+	StatusCode int    // Status code of the last response
+	Body       string // Body of the last response
+}
+
+// HTTPChecker sends requests through the services/proxy or pods/proxy subresource
+type HTTPChecker struct {
+	Client *Client
+	Config *HTTPConfig
+
+	httpClient     *http.Client
+	path           *url.URL
+	bodyPattern    *regexp.Regexp
+	jsonPath       *jsonpath.JSONPath
+	headerPatterns map[string]*regexp.Regexp
+	lastStatusCode int
+	lastBody       string
+}
+
+// WaitForHTTP waits until the endpoint answers with the expected status, body and headers
+func (c *HTTPChecker) WaitForHTTP(ctx context.Context) (*HTTPResult, error) {
+	if err := c.prepare(); err != nil {
+		return nil, err
+	}
+
+	description := fmt.Sprintf("%s %s on %s", c.Config.Method, c.Config.Path, c.describeTarget())
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, description, c.CheckHTTP)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	return &HTTPResult{
+		WaitResult: *result,
+		StatusCode: c.lastStatusCode,
+		Body:       c.lastBody,
+	}, err
+}
+
+// prepare creates the authenticated HTTP client and compiles the patterns
+func (c *HTTPChecker) prepare() error {
+	if c.Config.Service == "" && c.Config.Pod == "" {
+		return fmt.Errorf("either a service or a pod must be set")
+	}
+
+	// The API server authenticates the request, the proxied app sees an unauthenticated one
+	httpClient, err := rest.HTTPClientFor(c.Client.Config)
+	if err != nil {
+		return fmt.Errorf("creating HTTP client: %w", err)
+	}
+	httpClient.Timeout = httpRequestTimeout
+	c.httpClient = httpClient
+
+	if c.path, err = url.Parse(c.Config.Path); err != nil {
+		return fmt.Errorf("invalid path %s: %w", c.Config.Path, err)
+	}
+
+	if c.Config.BodyPattern != "" {
+		if c.bodyPattern, err = regexp.Compile(c.Config.BodyPattern); err != nil {
+			return fmt.Errorf("invalid body pattern: %w", err)
+		}
+	}
+	if c.Config.JSONPath != "" {
+		c.jsonPath = jsonpath.New("body")
+		if err := c.jsonPath.Parse(jsonPathTemplate(c.Config.JSONPath)); err != nil {
+			return fmt.Errorf("invalid JSONPath %s: %w", c.Config.JSONPath, err)
+		}
+	}
+
+	c.headerPatterns = make(map[string]*regexp.Regexp, len(c.Config.ResponseHeaders))
+	for header, pattern := range c.Config.ResponseHeaders {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern for header %s: %w", header, err)
+		}
+		c.headerPatterns[header] = re
+	}
+
+	return nil
+}
+
+// CheckHTTP sends a single request and checks the response
+func (c *HTTPChecker) CheckHTTP(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	var body io.Reader
+	if c.Config.RequestBody != "" {
+		body = strings.NewReader(c.Config.RequestBody)
+	}
+	request, err := http.NewRequestWithContext(ctx, c.Config.Method, c.proxyURL(), body)
+	if err != nil {
+		message := fmt.Sprintf("Invalid request: %s", err)
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      message,
+		}, errors.New(message)
+	}
+	for header, value := range c.Config.RequestHeaders {
+		request.Header.Set(header, value)
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		// Connection problems with the API server are retried like API errors
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Request to %s failed: %s", c.describeTarget(), err),
+		}, nil
+	}
+	defer response.Body.Close()
+
+	responseBody, err := io.ReadAll(io.LimitReader(response.Body, maxResponseSize))
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Reading response from %s failed: %s", c.describeTarget(), err),
+		}, nil
+	}
+	c.lastStatusCode = response.StatusCode
+	c.lastBody = string(responseBody)
+
+	// The API server rejecting the credentials will not change by waiting
+	if response.StatusCode != c.Config.StatusCode {
+		if status := proxyRejection(responseBody); status != nil {
+			message := fmt.Sprintf("API server rejected the request to %s: %s", c.describeTarget(), status.Message)
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      message,
+			}, errors.New(message)
+		}
+	}
+
+	if problem := c.checkResponse(response, responseBody); problem != "" {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("%s answered %d: %s", c.describeTarget(), response.StatusCode, problem),
+		}, nil
+	}
+
+	return &WaitResult{
+		ConditionMet: true,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("%s answered %d", c.describeTarget(), response.StatusCode),
+	}, nil
+}
+
+// checkResponse returns what does not match in a response, or an empty string
+func (c *HTTPChecker) checkResponse(response *http.Response, body []byte) string {
+	// Errors of the API server proxy, like missing endpoints, end up here too
+	if response.StatusCode != c.Config.StatusCode {
+		return fmt.Sprintf("expected status %d, body: %s", c.Config.StatusCode, tailOutput(strings.TrimSpace(string(body))))
+	}
+
+	var mismatched []string
+	for header, re := range c.headerPatterns {
+		if !re.MatchString(response.Header.Get(header)) {
+			mismatched = append(mismatched, header)
+		}
+	}
+	if len(mismatched) > 0 {
+		sort.Strings(mismatched)
+		return fmt.Sprintf("headers not matching: %s", strings.Join(mismatched, ", "))
+	}
+
+	if c.bodyPattern != nil && !c.bodyPattern.Match(body) {
+		return fmt.Sprintf("body does not match %q", c.Config.BodyPattern)
+	}
+
+	if c.jsonPath != nil {
+		var data interface{}
+		if err := json.Unmarshal(body, &data); err != nil {
+			return fmt.Sprintf("body is not JSON: %s", err)
+		}
+
+		var value bytes.Buffer
+		if err := c.jsonPath.Execute(&value, data); err != nil {
+			return fmt.Sprintf("JSONPath %s not found: %s", c.Config.JSONPath, err)
+		}
+		if c.Config.JSONValue == "" && value.Len() == 0 {
+			return fmt.Sprintf("JSONPath %s is empty", c.Config.JSONPath)
+		}
+		if c.Config.JSONValue != "" && value.String() != c.Config.JSONValue {
+			return fmt.Sprintf("JSONPath %s is %q, expected %q", c.Config.JSONPath, value.String(), c.Config.JSONValue)
+		}
+	}
+
+	return ""
+}
+
+// proxyRejection returns the Status the API server answers with when it denies
+// access to the proxy subresource, or nil for any other response
+func proxyRejection(body []byte) *metav1.Status {
+	var status metav1.Status
+	if err := json.Unmarshal(body, &status); err != nil || status.Kind != "Status" {
+		return nil
+	}
+	if status.Reason != metav1.StatusReasonUnauthorized && status.Reason != metav1.StatusReasonForbidden {
+		return nil
+	}
+	return &status
+}
+
+// proxyURL returns the URL of the path behind the services/proxy or pods/proxy subresource
+func (c *HTTPChecker) proxyURL() string {
+	resource, name := "services", c.Config.Service
+	if name == "" {
+		resource, name = "pods", c.Config.Pod
+	}
+
+	// The proxy addresses targets as [scheme:]name[:port]
+	if c.Config.Port != "" {
+		name = fmt.Sprintf("%s:%s", name, c.Config.Port)
+	}
+	if c.Config.Scheme != "" && c.Config.Scheme != "http" {
+		name = fmt.Sprintf("%s:%s", c.Config.Scheme, name)
+		if c.Config.Port == "" {
+			name = name + ":"
+		}
+	}
+
+	proxyURL := c.Client.Clientset.CoreV1().RESTClient().Get().
+		Namespace(c.Config.Namespace).
+		Resource(resource).
+		Name(name).
+		SubResource("proxy").
+		URL()
+
+	// The path is appended as is, the request builder would drop trailing slashes
+	proxyURL.Path = fmt.Sprintf("%s/%s", strings.TrimSuffix(proxyURL.Path, "/"), strings.TrimPrefix(c.path.Path, "/"))
+	proxyURL.RawQuery = c.path.RawQuery
+	return proxyURL.String()
+}
+
+// describeTarget describes the proxied service or pod for messages
+func (c *HTTPChecker) describeTarget() string {
+	target := fmt.Sprintf("service %s/%s", c.Config.Namespace, c.Config.Service)
+	if c.Config.Service == "" {
+		target = fmt.Sprintf("pod %s/%s", c.Config.Namespace, c.Config.Pod)
+	}
+	if c.Config.Port != "" {
+		target = fmt.Sprintf("%s port %s", target, c.Config.Port)
+	}
+	return target
+}
+
+// jsonPathTemplate wraps a bare JSONPath expression like ".status" in braces
+func jsonPathTemplate(expression string) string {
+	if strings.HasPrefix(expression, "{") {
+		return expression
+	}
+	return fmt.Sprintf("{%s}", expression)
+}
@@ -0,0 +1,192 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// workloadPodsOnWrongImage lists the pods selected by a workload selector and
+// returns those not running the expected images
+func (c *ConditionChecker) workloadPodsOnWrongImage(ctx context.Context, namespace string, selector *metav1.LabelSelector) ([]string, error) {
+	labelSelector, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	podList, err := c.Client.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.podsOnWrongImage(podList.Items), nil
+}
+
+// podsOnWrongImage returns a description of every pod whose containers are not
+// running the image configured for them in Images. Completed and terminating
+// pods are ignored.
+func (c *ConditionChecker) podsOnWrongImage(pods []corev1.Pod) []string {
+	containerNames := make([]string, 0, len(c.Config.Images))
+	for name := range c.Config.Images {
+		containerNames = append(containerNames, name)
+	}
+	sort.Strings(containerNames)
+
+	problems := []string{}
+	for _, containerName := range containerNames {
+		expected := c.Config.Images[containerName]
+		expectedDigest := imageDigest(expected)
+
+		// Pods running a tag are compared against each other when digests are compared
+		digests := map[string][]string{}
+
+		for i := range pods {
+			pod := &pods[i]
+			if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed || pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			image, found := findContainerImage(pod, containerName)
+			if !found {
+				problems = append(problems, fmt.Sprintf("%s: no container %s", pod.Name, containerName))
+				continue
+			}
+			if normalizeImageReference(image) != normalizeImageReference(expected) {
+				problems = append(problems, fmt.Sprintf("%s: container %s runs %s", pod.Name, containerName, image))
+				continue
+			}
+
+			if !c.Config.CompareImageDigests {
+				continue
+			}
+
+			status, found := findContainerStatus(pod, containerName)
+			digest := imageDigest(status.ImageID)
+			if !found || digest == "" {
+				problems = append(problems, fmt.Sprintf("%s: container %s has not pulled its image yet", pod.Name, containerName))
+				continue
+			}
+			if expectedDigest != "" && digest != expectedDigest {
+				problems = append(problems, fmt.Sprintf("%s: container %s runs digest %s", pod.Name, containerName, digest))
+				continue
+			}
+			digests[digest] = append(digests[digest], pod.Name)
+		}
+
+		if len(digests) > 1 {
+			descriptions := []string{}
+			for digest, podNames := range digests {
+				descriptions = append(descriptions, fmt.Sprintf("%s on %s", strings.Join(podNames, ", "), digest))
+			}
+			sort.Strings(descriptions)
+			problems = append(problems, fmt.Sprintf("container %s runs different digests of %s: %s", containerName, expected, strings.Join(descriptions, "; ")))
+		}
+	}
+
+	return problems
+}
+
+// deploymentRolledOut reports whether a Deployment observed its latest spec and
+// replaced all old pods, with no surge pods left
+func deploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+	status := deployment.Status
+	return status.ObservedGeneration >= deployment.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.Replicas == replicas &&
+		status.AvailableReplicas == replicas
+}
+
+// statefulSetRolledOut reports whether a StatefulSet observed its latest spec
+// and updated all of its pods to the current revision
+func statefulSetRolledOut(statefulset *appsv1.StatefulSet) bool {
+	replicas := int32(1)
+	if statefulset.Spec.Replicas != nil {
+		replicas = *statefulset.Spec.Replicas
+	}
+	status := statefulset.Status
+	return status.ObservedGeneration >= statefulset.Generation &&
+		status.UpdatedReplicas == replicas &&
+		status.Replicas == replicas &&
+		status.ReadyReplicas == replicas &&
+		status.CurrentRevision == status.UpdateRevision
+}
+
+// daemonSetRolledOut reports whether a DaemonSet observed its latest spec and
+// runs an updated, available pod on every node it is scheduled to
+func daemonSetRolledOut(daemonset *appsv1.DaemonSet) bool {
+	status := daemonset.Status
+	return status.ObservedGeneration >= daemonset.Generation &&
+		status.UpdatedNumberScheduled == status.DesiredNumberScheduled &&
+		status.CurrentNumberScheduled == status.DesiredNumberScheduled &&
+		status.NumberAvailable == status.DesiredNumberScheduled
+}
+
+// findContainerImage returns the image of the named container or init container in a pod spec
+func findContainerImage(pod *corev1.Pod, name string) (string, bool) {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return container.Image, true
+		}
+	}
+	for _, container := range pod.Spec.InitContainers {
+		if container.Name == name {
+			return container.Image, true
+		}
+	}
+	return "", false
+}
+
+// imageDigest returns the "sha256:..." digest of an image reference or
+// imageID such as "docker.io/library/nginx@sha256:..." or "docker-pullable://nginx@sha256:...",
+// or an empty string if it has none
+func imageDigest(image string) string {
+	if index := strings.Index(image, "sha256:"); index >= 0 {
+		return image[index:]
+	}
+	return ""
+}
+
+// normalizeImageReference expands an image reference to its fully qualified
+// form so that "nginx", "nginx:latest" and "docker.io/library/nginx:latest" compare equal
+func normalizeImageReference(image string) string {
+	name, digest, hasDigest := strings.Cut(image, "@")
+
+	tag := ""
+	if index := strings.LastIndex(name, ":"); index > strings.LastIndex(name, "/") {
+		name, tag = name[:index], name[index+1:]
+	}
+	if tag == "" && !hasDigest {
+		tag = "latest"
+	}
+
+	domain, path, found := strings.Cut(name, "/")
+	if !found || (!strings.ContainsAny(domain, ".:") && domain != "localhost") {
+		domain, path = "docker.io", name
+	}
+	if domain == "index.docker.io" {
+		domain = "docker.io"
+	}
+	if domain == "docker.io" && !strings.Contains(path, "/") {
+		path = "library/" + path
+	}
+
+	normalized := domain + "/" + path
+	if tag != "" {
+		normalized = normalized + ":" + tag
+	}
+	if hasDigest {
+		normalized = normalized + "@" + digest
+	}
+	return normalized
+}
@@ -0,0 +1,52 @@
+package kubernetes
+
+import "testing"
+
+func TestNormalizeImageReference(t *testing.T) {
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "nginx", want: "docker.io/library/nginx:latest"},
+		{image: "nginx:1.25", want: "docker.io/library/nginx:1.25"},
+		{image: "library/nginx:1.25", want: "docker.io/library/nginx:1.25"},
+		{image: "docker.io/library/nginx:1.25", want: "docker.io/library/nginx:1.25"},
+		{image: "index.docker.io/library/nginx:1.25", want: "docker.io/library/nginx:1.25"},
+		{image: "bitnami/redis:7.2", want: "docker.io/bitnami/redis:7.2"},
+		{image: "ghcr.io/org/app:v1", want: "ghcr.io/org/app:v1"},
+		{image: "ghcr.io/org/app", want: "ghcr.io/org/app:latest"},
+		{image: "localhost/app:dev", want: "localhost/app:dev"},
+		{image: "registry.local:5000/app", want: "registry.local:5000/app:latest"},
+		{image: "registry.local:5000/app:v2", want: "registry.local:5000/app:v2"},
+		{image: "nginx@sha256:abc123", want: "docker.io/library/nginx@sha256:abc123"},
+		{image: "nginx:1.25@sha256:abc123", want: "docker.io/library/nginx:1.25@sha256:abc123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if got := normalizeImageReference(tt.image); got != tt.want {
+				t.Errorf("normalizeImageReference(%q) = %q, want %q", tt.image, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImageDigest(t *testing.T) {
+	tests := []struct {
+		image string
+		want  string
+	}{
+		{image: "docker.io/library/nginx@sha256:abc123", want: "sha256:abc123"},
+		{image: "docker-pullable://nginx@sha256:abc123", want: "sha256:abc123"},
+		{image: "sha256:abc123", want: "sha256:abc123"},
+		{image: "nginx:1.25", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			if got := imageDigest(tt.image); got != tt.want {
+				t.Errorf("imageDigest(%q) = %q, want %q", tt.image, got, tt.want)
+			}
+		})
+	}
+}
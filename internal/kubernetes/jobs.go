@@ -0,0 +1,148 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Job condition types added after the client-go version in use
+const (
+	jobSuccessCriteriaMet batchv1.JobConditionType = "SuccessCriteriaMet"
+	jobFailureTarget      batchv1.JobConditionType = "FailureTarget"
+)
+
+// jobCondition returns the job condition of the given type if it is True
+func jobCondition(job *batchv1.Job, conditionType batchv1.JobConditionType) (batchv1.JobCondition, bool) {
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == conditionType && condition.Status == corev1.ConditionTrue {
+			return condition, true
+		}
+	}
+	return batchv1.JobCondition{}, false
+}
+
+// jobSucceeded reports whether a job has succeeded, honoring spec.completions,
+// the completed indexes of Indexed jobs and success policies, along with a
+// description of its progress
+func jobSucceeded(job *batchv1.Job) (bool, string) {
+	if _, found := jobCondition(job, batchv1.JobComplete); found {
+		return true, "complete"
+	}
+	if _, found := jobCondition(job, jobSuccessCriteriaMet); found {
+		return true, "success criteria met"
+	}
+
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		return false, "suspended"
+	}
+
+	// Without completions any successful pod completes a work queue job once no pods are active
+	if job.Spec.Completions == nil {
+		return job.Status.Succeeded > 0 && job.Status.Active == 0, fmt.Sprintf("%d succeeded, %d active", job.Status.Succeeded, job.Status.Active)
+	}
+
+	completions := *job.Spec.Completions
+	succeeded := job.Status.Succeeded
+	if job.Spec.CompletionMode != nil && *job.Spec.CompletionMode == batchv1.IndexedCompletion {
+		succeeded = countCompletedIndexes(job.Status.CompletedIndexes)
+	}
+	return succeeded >= completions, fmt.Sprintf("%d/%d completions", succeeded, completions)
+}
+
+// jobFailed reports whether a job has failed terminally, either with the
+// Failed condition or with a FailureTarget set by its pod failure policy
+func jobFailed(job *batchv1.Job) (bool, string) {
+	for _, conditionType := range []batchv1.JobConditionType{batchv1.JobFailed, jobFailureTarget} {
+		if condition, found := jobCondition(job, conditionType); found {
+			if condition.Message != "" {
+				return true, fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+			}
+			return true, condition.Reason
+		}
+	}
+	return false, ""
+}
+
+// countCompletedIndexes counts the indexes in a compressed list like "1,3-5,7"
+func countCompletedIndexes(completedIndexes string) int32 {
+	count := int32(0)
+	for _, interval := range strings.Split(completedIndexes, ",") {
+		if interval == "" {
+			continue
+		}
+		first, last, isRange := strings.Cut(interval, "-")
+		if !isRange {
+			count++
+			continue
+		}
+		start, err := strconv.Atoi(first)
+		if err != nil {
+			continue
+		}
+		end, err := strconv.Atoi(last)
+		if err != nil || end < start {
+			continue
+		}
+		count += int32(end - start + 1)
+	}
+	return count
+}
+
+// jobPodFailure describes the most recent failed container of a job's pods,
+// using its termination message when there is one
+func (c *ConditionChecker) jobPodFailure(ctx context.Context, job *batchv1.Job) (string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+
+	podList, err := c.Client.Clientset.CoreV1().Pods(job.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var latest *corev1.ContainerStateTerminated
+	description := ""
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		controllerRef := metav1.GetControllerOf(pod)
+		if controllerRef == nil || controllerRef.UID != job.UID {
+			continue
+		}
+
+		// Pods failing without a container exit, e.g. when evicted
+		if pod.Status.Phase == corev1.PodFailed && pod.Status.Reason != "" && latest == nil && description == "" {
+			description = fmt.Sprintf("pod %s failed: %s %s", pod.Name, pod.Status.Reason, pod.Status.Message)
+		}
+
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			terminated := status.State.Terminated
+			if terminated == nil {
+				terminated = status.LastTerminationState.Terminated
+			}
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			if latest != nil && !terminated.FinishedAt.After(latest.FinishedAt.Time) {
+				continue
+			}
+
+			latest = terminated
+			description = fmt.Sprintf("container %s of pod %s exited with code %d (%s)", status.Name, pod.Name, terminated.ExitCode, terminated.Reason)
+			if message := strings.TrimSpace(terminated.Message); message != "" {
+				description = fmt.Sprintf("%s: %s", description, message)
+			}
+		}
+	}
+
+	return description, nil
+}
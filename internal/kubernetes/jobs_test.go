@@ -0,0 +1,154 @@
+package kubernetes
+
+import (
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func int32Ptr(value int32) *int32 {
+	return &value
+}
+
+func TestJobSucceeded(t *testing.T) {
+	indexed := batchv1.IndexedCompletion
+	suspend := true
+
+	tests := []struct {
+		name string
+		job  batchv1.Job
+		want bool
+	}{
+		{
+			name: "complete condition",
+			job: batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			}}},
+			want: true,
+		},
+		{
+			name: "success criteria met",
+			job: batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: jobSuccessCriteriaMet, Status: corev1.ConditionTrue},
+			}}},
+			want: true,
+		},
+		{
+			name: "complete condition not true",
+			job: batchv1.Job{Status: batchv1.JobStatus{Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobComplete, Status: corev1.ConditionFalse},
+			}}},
+			want: false,
+		},
+		{
+			name: "suspended",
+			job:  batchv1.Job{Spec: batchv1.JobSpec{Suspend: &suspend, Completions: int32Ptr(1)}, Status: batchv1.JobStatus{Succeeded: 1}},
+			want: false,
+		},
+		{
+			name: "work queue with active pods",
+			job:  batchv1.Job{Status: batchv1.JobStatus{Succeeded: 1, Active: 1}},
+			want: false,
+		},
+		{
+			name: "work queue finished",
+			job:  batchv1.Job{Status: batchv1.JobStatus{Succeeded: 1}},
+			want: true,
+		},
+		{
+			name: "completions not reached",
+			job:  batchv1.Job{Spec: batchv1.JobSpec{Completions: int32Ptr(3)}, Status: batchv1.JobStatus{Succeeded: 2}},
+			want: false,
+		},
+		{
+			name: "completions reached",
+			job:  batchv1.Job{Spec: batchv1.JobSpec{Completions: int32Ptr(3)}, Status: batchv1.JobStatus{Succeeded: 3}},
+			want: true,
+		},
+		{
+			name: "indexed job counts completed indexes",
+			job: batchv1.Job{
+				Spec:   batchv1.JobSpec{Completions: int32Ptr(5), CompletionMode: &indexed},
+				Status: batchv1.JobStatus{Succeeded: 5, CompletedIndexes: "0-2,4"},
+			},
+			want: false,
+		},
+		{
+			name: "indexed job with every index completed",
+			job: batchv1.Job{
+				Spec:   batchv1.JobSpec{Completions: int32Ptr(5), CompletionMode: &indexed},
+				Status: batchv1.JobStatus{Succeeded: 5, CompletedIndexes: "0-4"},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, description := jobSucceeded(&tt.job)
+			if got != tt.want {
+				t.Errorf("jobSucceeded() = %t (%s), want %t", got, description, tt.want)
+			}
+		})
+	}
+}
+
+func TestJobFailed(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []batchv1.JobCondition
+		want       bool
+		wantReason string
+	}{
+		{name: "no conditions", want: false},
+		{
+			name:       "failed",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"}},
+			want:       true,
+			wantReason: "BackoffLimitExceeded",
+		},
+		{
+			name:       "failure target of a pod failure policy",
+			conditions: []batchv1.JobCondition{{Type: jobFailureTarget, Status: corev1.ConditionTrue, Reason: "PodFailurePolicy", Message: "exit code 42"}},
+			want:       true,
+			wantReason: "PodFailurePolicy: exit code 42",
+		},
+		{
+			name:       "failed condition not true",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionFalse, Reason: "BackoffLimitExceeded"}},
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &batchv1.Job{Status: batchv1.JobStatus{Conditions: tt.conditions}}
+			got, reason := jobFailed(job)
+			if got != tt.want || reason != tt.wantReason {
+				t.Errorf("jobFailed() = %t, %q, want %t, %q", got, reason, tt.want, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestCountCompletedIndexes(t *testing.T) {
+	tests := []struct {
+		completedIndexes string
+		want             int32
+	}{
+		{completedIndexes: "", want: 0},
+		{completedIndexes: "3", want: 1},
+		{completedIndexes: "0-4", want: 5},
+		{completedIndexes: "1,3-5,7", want: 5},
+		{completedIndexes: "5-3", want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.completedIndexes, func(t *testing.T) {
+			if got := countCompletedIndexes(tt.completedIndexes); got != tt.want {
+				t.Errorf("countCompletedIndexes(%q) = %d, want %d", tt.completedIndexes, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package kubernetes
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// jsonPathCondition is a parsed "jsonpath=" condition like kubectl wait accepts:
+// "{.status.phase}=Running", ".status.phase==Running" or "{.spec.clusterIP}"
+type jsonPathCondition struct {
+	expression string
+	path       *jsonpath.JSONPath
+	expected   string
+	// expectedPath is set when the expected value is itself a JSONPath
+	// expression like "{.spec.replicas}"
+	expectedPath *jsonpath.JSONPath
+}
+
+// parseJSONPathCondition parses the value of a "jsonpath=" condition. Without an
+// expected value the condition is met when the expression yields a non-empty value.
+func parseJSONPathCondition(value string) (*jsonPathCondition, error) {
+	expression, expected, compare := splitJSONPathCondition(value)
+	expression = strings.Trim(strings.TrimSpace(expression), "'")
+	if expression == "" {
+		return nil, fmt.Errorf("jsonpath condition needs an expression, e.g. jsonpath={.status.phase}=Running")
+	}
+
+	condition := &jsonPathCondition{expression: expression}
+	condition.path = jsonpath.New("condition")
+	if err := condition.path.Parse(jsonPathTemplate(expression)); err != nil {
+		return nil, fmt.Errorf("invalid JSONPath %s: %w", expression, err)
+	}
+
+	if compare {
+		condition.expected = strings.Trim(strings.TrimSpace(expected), "'\"")
+		if strings.HasPrefix(condition.expected, "{") {
+			condition.expectedPath = jsonpath.New("expected")
+			if err := condition.expectedPath.Parse(condition.expected); err != nil {
+				return nil, fmt.Errorf("invalid JSONPath %s: %w", condition.expected, err)
+			}
+		}
+	}
+
+	return condition, nil
+}
+
+// splitJSONPathCondition splits a condition at the first "=" or "==" outside of
+// braces, brackets, parentheses and quotes, so filters like
+// [?(@.type=="Ready")] stay part of the expression
+func splitJSONPathCondition(value string) (string, string, bool) {
+	depth := 0
+	var quote rune
+	for i, char := range value {
+		switch {
+		case quote != 0:
+			if char == quote {
+				quote = 0
+			}
+		case char == '"':
+			quote = char
+		case char == '{' || char == '[' || char == '(':
+			depth++
+		case char == '}' || char == ']' || char == ')':
+			depth--
+		case char == '=' && depth == 0:
+			return value[:i], strings.TrimPrefix(value[i+1:], "="), true
+		}
+	}
+	return value, "", false
+}
+
+// matches evaluates the condition against an object. When it is not met the
+// returned description explains what was found instead.
+func (c *jsonPathCondition) matches(obj map[string]interface{}) (bool, string) {
+	var value bytes.Buffer
+	if err := c.path.Execute(&value, obj); err != nil {
+		return false, fmt.Sprintf("%s not found", c.expression)
+	}
+
+	if c.expectedPath == nil && c.expected == "" {
+		if value.Len() == 0 {
+			return false, fmt.Sprintf("%s is empty", c.expression)
+		}
+		return true, ""
+	}
+
+	expected := c.expected
+	if c.expectedPath != nil {
+		var expectedValue bytes.Buffer
+		if err := c.expectedPath.Execute(&expectedValue, obj); err != nil {
+			return false, fmt.Sprintf("%s not found", c.expected)
+		}
+		expected = expectedValue.String()
+	}
+
+	if value.String() != expected {
+		return false, fmt.Sprintf("%s is %q, expected %q", c.expression, value.String(), expected)
+	}
+	return true, ""
+}
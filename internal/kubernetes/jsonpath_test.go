@@ -0,0 +1,103 @@
+package kubernetes
+
+import "testing"
+
+func TestSplitJSONPathCondition(t *testing.T) {
+	tests := []struct {
+		value          string
+		wantExpression string
+		wantExpected   string
+		wantCompare    bool
+	}{
+		{value: "{.status.phase}=Running", wantExpression: "{.status.phase}", wantExpected: "Running", wantCompare: true},
+		{value: ".status.phase==Running", wantExpression: ".status.phase", wantExpected: "Running", wantCompare: true},
+		{value: "{.spec.clusterIP}", wantExpression: "{.spec.clusterIP}", wantCompare: false},
+		{
+			value:          `{.status.conditions[?(@.type=="Ready")].status}=True`,
+			wantExpression: `{.status.conditions[?(@.type=="Ready")].status}`,
+			wantExpected:   "True",
+			wantCompare:    true,
+		},
+		{
+			value:          `.status.conditions[?(@.type=="Ready")].status==True`,
+			wantExpression: `.status.conditions[?(@.type=="Ready")].status`,
+			wantExpected:   "True",
+			wantCompare:    true,
+		},
+		{value: `{.metadata.annotations.note}="a=b"`, wantExpression: "{.metadata.annotations.note}", wantExpected: `"a=b"`, wantCompare: true},
+		{value: "{.status.readyReplicas}={.spec.replicas}", wantExpression: "{.status.readyReplicas}", wantExpected: "{.spec.replicas}", wantCompare: true},
+		{value: "{.status.phase}=", wantExpression: "{.status.phase}", wantExpected: "", wantCompare: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			expression, expected, compare := splitJSONPathCondition(tt.value)
+			if expression != tt.wantExpression || expected != tt.wantExpected || compare != tt.wantCompare {
+				t.Errorf("splitJSONPathCondition(%q) = %q, %q, %t, want %q, %q, %t",
+					tt.value, expression, expected, compare, tt.wantExpression, tt.wantExpected, tt.wantCompare)
+			}
+		})
+	}
+}
+
+func TestParseJSONPathCondition(t *testing.T) {
+	obj := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":        "web",
+			"annotations": map[string]interface{}{"note": "a=b"},
+		},
+		"spec": map[string]interface{}{"replicas": int64(3), "clusterIP": ""},
+		"status": map[string]interface{}{
+			"phase":         "Running",
+			"readyReplicas": int64(3),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Available", "status": "False"},
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}
+
+	tests := []struct {
+		value     string
+		wantError bool
+		want      bool
+	}{
+		{value: "{.status.phase}=Running", want: true},
+		{value: ".status.phase==Running", want: true},
+		{value: "{.status.phase}=Pending", want: false},
+		{value: "'{.status.phase}'=Running", want: true},
+		{value: `{.status.phase}="Running"`, want: true},
+		{value: "{.status.phase}='Running'", want: true},
+		{value: `{.status.conditions[?(@.type=="Ready")].status}=True`, want: true},
+		{value: `.status.conditions[?(@.type=="Available")].status==True`, want: false},
+		{value: `{.metadata.annotations.note}="a=b"`, want: true},
+		{value: "{.status.readyReplicas}={.spec.replicas}", want: true},
+		{value: "{.spec.replicas}={.status.missing}", want: false},
+		{value: "{.status.phase}", want: true},
+		{value: "{.spec.clusterIP}", want: false},
+		{value: "{.status.missing}", want: false},
+		{value: "{.status.missing}=Running", want: false},
+		{value: "", wantError: true},
+		{value: "=Running", wantError: true},
+		{value: "{.status.phase=Running", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			condition, err := parseJSONPathCondition(tt.value)
+			if tt.wantError {
+				if err == nil {
+					t.Fatalf("parseJSONPathCondition(%q) expected an error", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseJSONPathCondition(%q) unexpected error: %s", tt.value, err)
+			}
+
+			if got, description := condition.matches(obj); got != tt.want {
+				t.Errorf("%q matches = %t (%s), want %t", tt.value, got, description, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package kubernetes
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// resourceStatus is the reconciliation status of an object, following the
+// kstatus conventions of sigs.k8s.io/cli-utils
+type resourceStatus string
+
+const (
+	statusInProgress  resourceStatus = "InProgress"
+	statusCurrent     resourceStatus = "Current"
+	statusFailed      resourceStatus = "Failed"
+	statusTerminating resourceStatus = "Terminating"
+)
+
+// statusFunc computes the status of an object of a specific kind
+type statusFunc func(obj *unstructured.Unstructured) (resourceStatus, string)
+
+// kindStatusFuncs holds the status computations for built-in kinds, keyed by group and kind
+var kindStatusFuncs = map[schema.GroupKind]statusFunc{
+	{Group: "apps", Kind: "Deployment"}:                               deploymentStatus,
+	{Group: "apps", Kind: "StatefulSet"}:                              statefulSetStatus,
+	{Group: "apps", Kind: "DaemonSet"}:                                daemonSetStatus,
+	{Group: "apps", Kind: "ReplicaSet"}:                               replicaSetStatus,
+	{Group: "batch", Kind: "Job"}:                                     jobStatus,
+	{Group: "policy", Kind: "PodDisruptionBudget"}:                    podDisruptionBudgetStatus,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: customResourceDefinitionStatus,
+	{Kind: "Pod"}:                   podStatus,
+	{Kind: "PersistentVolumeClaim"}: persistentVolumeClaimStatus,
+	{Kind: "Service"}:               serviceStatus,
+}
+
+// computeStatus computes whether an object is reconciled (Current), still
+// being reconciled (InProgress), unable to reconcile (Failed) or being deleted
+func computeStatus(groupKind schema.GroupKind, obj *unstructured.Unstructured) (resourceStatus, string) {
+	if obj.GetDeletionTimestamp() != nil {
+		return statusTerminating, "Resource scheduled for deletion"
+	}
+
+	observedGeneration, found, _ := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if found && observedGeneration < obj.GetGeneration() {
+		return statusInProgress, fmt.Sprintf("Generation %d not observed yet, observed %d", obj.GetGeneration(), observedGeneration)
+	}
+
+	if compute, found := kindStatusFuncs[groupKind]; found {
+		return compute(obj)
+	}
+	return conditionsStatus(obj)
+}
+
+// conditionsStatus computes the status of objects following the standard
+// condition conventions: Reconciling, Stalled and Ready
+func conditionsStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	if condition, found := findUnstructuredCondition(obj, "Stalled"); found && condition.Status == "True" {
+		return statusFailed, describeCondition(condition)
+	}
+	if condition, found := findUnstructuredCondition(obj, "Reconciling"); found && condition.Status == "True" {
+		return statusInProgress, describeCondition(condition)
+	}
+	if condition, found := findUnstructuredCondition(obj, "Ready"); found {
+		if condition.Status != "True" || !condition.isCurrent(obj.GetGeneration()) {
+			return statusInProgress, describeCondition(condition)
+		}
+	}
+	return statusCurrent, "Resource is current"
+}
+
+// nestedInt64 reads an integer field, returning the default when it is not set
+func nestedInt64(obj *unstructured.Unstructured, defaultValue int64, fields ...string) int64 {
+	value, found, err := unstructured.NestedInt64(obj.Object, fields...)
+	if !found || err != nil {
+		return defaultValue
+	}
+	return value
+}
+
+// deploymentStatus requires every replica to be updated, available and ready
+func deploymentStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	if condition, found := findUnstructuredCondition(obj, "Progressing"); found && condition.Status == "False" && condition.Reason == "ProgressDeadlineExceeded" {
+		return statusFailed, describeCondition(condition)
+	}
+
+	replicas := nestedInt64(obj, 1, "spec", "replicas")
+	statusReplicas := nestedInt64(obj, 0, "status", "replicas")
+	updatedReplicas := nestedInt64(obj, 0, "status", "updatedReplicas")
+	readyReplicas := nestedInt64(obj, 0, "status", "readyReplicas")
+	availableReplicas := nestedInt64(obj, 0, "status", "availableReplicas")
+
+	switch {
+	case updatedReplicas < replicas:
+		return statusInProgress, fmt.Sprintf("Updated: %d/%d", updatedReplicas, replicas)
+	case statusReplicas > updatedReplicas:
+		return statusInProgress, fmt.Sprintf("Pending termination: %d", statusReplicas-updatedReplicas)
+	case availableReplicas < updatedReplicas:
+		return statusInProgress, fmt.Sprintf("Available: %d/%d", availableReplicas, updatedReplicas)
+	case readyReplicas < replicas:
+		return statusInProgress, fmt.Sprintf("Ready: %d/%d", readyReplicas, replicas)
+	}
+
+	if condition, found := findUnstructuredCondition(obj, "Available"); found && condition.Status != "True" {
+		return statusInProgress, describeCondition(condition)
+	}
+	return statusCurrent, fmt.Sprintf("Deployment is available. Replicas: %d", replicas)
+}
+
+// statefulSetStatus requires every replica to be ready and on the update revision
+func statefulSetStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	replicas := nestedInt64(obj, 1, "spec", "replicas")
+	statusReplicas := nestedInt64(obj, 0, "status", "replicas")
+	readyReplicas := nestedInt64(obj, 0, "status", "readyReplicas")
+	updatedReplicas := nestedInt64(obj, 0, "status", "updatedReplicas")
+
+	switch {
+	case statusReplicas < replicas:
+		return statusInProgress, fmt.Sprintf("Replicas: %d/%d", statusReplicas, replicas)
+	case readyReplicas < replicas:
+		return statusInProgress, fmt.Sprintf("Ready: %d/%d", readyReplicas, replicas)
+	}
+
+	// Pods are only replaced by hand with the OnDelete strategy
+	strategy, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type")
+	if strategy == "OnDelete" {
+		return statusCurrent, fmt.Sprintf("StatefulSet is ready. Replicas: %d", replicas)
+	}
+
+	if partition, found, _ := unstructured.NestedInt64(obj.Object, "spec", "updateStrategy", "rollingUpdate", "partition"); found && partition > 0 {
+		if updatedReplicas < replicas-partition {
+			return statusInProgress, fmt.Sprintf("Updated: %d/%d above partition %d", updatedReplicas, replicas-partition, partition)
+		}
+		return statusCurrent, fmt.Sprintf("Partitioned rollout complete. Updated: %d", updatedReplicas)
+	}
+
+	currentRevision, _, _ := unstructured.NestedString(obj.Object, "status", "currentRevision")
+	updateRevision, _, _ := unstructured.NestedString(obj.Object, "status", "updateRevision")
+	if currentRevision != updateRevision {
+		return statusInProgress, fmt.Sprintf("Updated: %d/%d, waiting for revision %s", updatedReplicas, replicas, updateRevision)
+	}
+	return statusCurrent, fmt.Sprintf("StatefulSet is ready. Replicas: %d", replicas)
+}
+
+// daemonSetStatus requires the pods on every scheduled node to be updated, available and ready
+func daemonSetStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	desired := nestedInt64(obj, 0, "status", "desiredNumberScheduled")
+	current := nestedInt64(obj, 0, "status", "currentNumberScheduled")
+	updated := nestedInt64(obj, 0, "status", "updatedNumberScheduled")
+	available := nestedInt64(obj, 0, "status", "numberAvailable")
+	ready := nestedInt64(obj, 0, "status", "numberReady")
+
+	switch {
+	case current < desired:
+		return statusInProgress, fmt.Sprintf("Scheduled: %d/%d", current, desired)
+	case updated < desired:
+		return statusInProgress, fmt.Sprintf("Updated: %d/%d", updated, desired)
+	case available < desired:
+		return statusInProgress, fmt.Sprintf("Available: %d/%d", available, desired)
+	case ready < desired:
+		return statusInProgress, fmt.Sprintf("Ready: %d/%d", ready, desired)
+	}
+	return statusCurrent, fmt.Sprintf("All replicas scheduled as expected. Replicas: %d", desired)
+}
+
+// replicaSetStatus requires every replica to be available and ready
+func replicaSetStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	if condition, found := findUnstructuredCondition(obj, "ReplicaFailure"); found && condition.Status == "True" {
+		return statusFailed, describeCondition(condition)
+	}
+
+	replicas := nestedInt64(obj, 1, "spec", "replicas")
+	available := nestedInt64(obj, 0, "status", "availableReplicas")
+	ready := nestedInt64(obj, 0, "status", "readyReplicas")
+
+	switch {
+	case available < replicas:
+		return statusInProgress, fmt.Sprintf("Available: %d/%d", available, replicas)
+	case ready < replicas:
+		return statusInProgress, fmt.Sprintf("Ready: %d/%d", ready, replicas)
+	}
+	return statusCurrent, fmt.Sprintf("ReplicaSet is available. Replicas: %d", replicas)
+}
+
+// jobStatus treats a job as current once it has completed
+func jobStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	if condition, found := findUnstructuredCondition(obj, "Failed"); found && condition.Status == "True" {
+		return statusFailed, describeCondition(condition)
+	}
+	if condition, found := findUnstructuredCondition(obj, "Complete"); found && condition.Status == "True" {
+		return statusCurrent, "Job Completed"
+	}
+
+	succeeded := nestedInt64(obj, 0, "status", "succeeded")
+	active := nestedInt64(obj, 0, "status", "active")
+	return statusInProgress, fmt.Sprintf("Job in progress. Active: %d, succeeded: %d", active, succeeded)
+}
+
+// podDisruptionBudgetStatus requires enough healthy pods to allow disruptions
+func podDisruptionBudgetStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	currentHealthy := nestedInt64(obj, 0, "status", "currentHealthy")
+	desiredHealthy := nestedInt64(obj, 0, "status", "desiredHealthy")
+	if currentHealthy < desiredHealthy {
+		return statusInProgress, fmt.Sprintf("Budget not met. Healthy: %d/%d", currentHealthy, desiredHealthy)
+	}
+	return statusCurrent, fmt.Sprintf("Budget is met. Healthy: %d/%d", currentHealthy, desiredHealthy)
+}
+
+// customResourceDefinitionStatus requires the CRD to be Established with its names accepted
+func customResourceDefinitionStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	if condition, found := findUnstructuredCondition(obj, "NamesAccepted"); found && condition.Status == "False" {
+		return statusFailed, describeCondition(condition)
+	}
+	if condition, found := findUnstructuredCondition(obj, "Established"); !found || condition.Status != "True" {
+		return statusInProgress, "CRD is not established"
+	}
+	return statusCurrent, "CRD is established"
+}
+
+// podStatus treats running pods as current once ready, and crash looping pods as failed
+func podStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return statusCurrent, "Pod has completed successfully"
+	case "Failed":
+		return statusFailed, "Pod has failed"
+	}
+
+	containerStatuses, _, _ := unstructured.NestedSlice(obj.Object, "status", "containerStatuses")
+	for _, rawStatus := range containerStatuses {
+		containerStatus, ok := rawStatus.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		reason, _, _ := unstructured.NestedString(containerStatus, "state", "waiting", "reason")
+		if reason == "CrashLoopBackOff" {
+			name, _, _ := unstructured.NestedString(containerStatus, "name")
+			return statusFailed, fmt.Sprintf("Container %s is in %s", name, reason)
+		}
+	}
+
+	if condition, found := findUnstructuredCondition(obj, "PodScheduled"); found && condition.Status == "False" {
+		return statusInProgress, describeCondition(condition)
+	}
+	if condition, found := findUnstructuredCondition(obj, "Ready"); !found || condition.Status != "True" {
+		return statusInProgress, fmt.Sprintf("Pod phase %s, not ready", valueOrUnknown(phase))
+	}
+	return statusCurrent, "Pod is Ready"
+}
+
+// persistentVolumeClaimStatus requires the claim to be bound
+func persistentVolumeClaimStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase != "Bound" {
+		return statusInProgress, fmt.Sprintf("PVC phase %s", valueOrUnknown(phase))
+	}
+	return statusCurrent, "PVC is Bound"
+}
+
+// serviceStatus requires load balancer services to have an ingress assigned
+func serviceStatus(obj *unstructured.Unstructured) (resourceStatus, string) {
+	serviceType, _, _ := unstructured.NestedString(obj.Object, "spec", "type")
+	if serviceType != "LoadBalancer" {
+		return statusCurrent, "Service is ready"
+	}
+
+	ingress, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+	if len(ingress) == 0 {
+		return statusInProgress, "Load balancer not assigned yet"
+	}
+	return statusCurrent, "Service is ready"
+}
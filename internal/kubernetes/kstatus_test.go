@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func testCondition(conditionType, status, reason string) interface{} {
+	return map[string]interface{}{"type": conditionType, "status": status, "reason": reason}
+}
+
+func testDeployment(generation int64, status map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "generation": generation},
+		"spec":       map[string]interface{}{"replicas": int64(3)},
+		"status":     status,
+	}}
+}
+
+func testCustomResource(generation int64, conditions ...interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "example.com/v1",
+		"kind":       "Widget",
+		"metadata":   map[string]interface{}{"name": "widget", "generation": generation},
+		"status":     map[string]interface{}{"conditions": conditions},
+	}}
+}
+
+func TestComputeStatus(t *testing.T) {
+	deploymentKind := schema.GroupKind{Group: "apps", Kind: "Deployment"}
+	widgetKind := schema.GroupKind{Group: "example.com", Kind: "Widget"}
+
+	available := func() map[string]interface{} {
+		return map[string]interface{}{
+			"observedGeneration": int64(2),
+			"replicas":           int64(3),
+			"updatedReplicas":    int64(3),
+			"readyReplicas":      int64(3),
+			"availableReplicas":  int64(3),
+			"conditions":         []interface{}{testCondition("Available", "True", "MinimumReplicasAvailable")},
+		}
+	}
+
+	deleted := testDeployment(2, available())
+	deletionTimestamp := metav1.Now()
+	deleted.SetDeletionTimestamp(&deletionTimestamp)
+
+	rollingOut := available()
+	rollingOut["updatedReplicas"] = int64(1)
+
+	terminatingOld := available()
+	terminatingOld["replicas"] = int64(4)
+
+	unavailable := available()
+	unavailable["availableReplicas"] = int64(2)
+
+	deadlineExceeded := available()
+	deadlineExceeded["updatedReplicas"] = int64(1)
+	deadlineExceeded["conditions"] = []interface{}{testCondition("Progressing", "False", "ProgressDeadlineExceeded")}
+
+	staleReady := map[string]interface{}{"type": "Ready", "status": "True", "observedGeneration": int64(1)}
+
+	tests := []struct {
+		name      string
+		groupKind schema.GroupKind
+		obj       *unstructured.Unstructured
+		want      resourceStatus
+	}{
+		{name: "deployment available", groupKind: deploymentKind, obj: testDeployment(2, available()), want: statusCurrent},
+		{name: "deployment being deleted", groupKind: deploymentKind, obj: deleted, want: statusTerminating},
+		{name: "deployment generation not observed", groupKind: deploymentKind, obj: testDeployment(3, available()), want: statusInProgress},
+		{name: "deployment replicas being updated", groupKind: deploymentKind, obj: testDeployment(2, rollingOut), want: statusInProgress},
+		{name: "deployment old replicas terminating", groupKind: deploymentKind, obj: testDeployment(2, terminatingOld), want: statusInProgress},
+		{name: "deployment replicas not available", groupKind: deploymentKind, obj: testDeployment(2, unavailable), want: statusInProgress},
+		{name: "deployment past progress deadline", groupKind: deploymentKind, obj: testDeployment(2, deadlineExceeded), want: statusFailed},
+		{name: "custom resource ready", groupKind: widgetKind, obj: testCustomResource(1, testCondition("Ready", "True", "Reconciled")), want: statusCurrent},
+		{name: "custom resource without conditions", groupKind: widgetKind, obj: testCustomResource(1), want: statusCurrent},
+		{name: "custom resource not ready", groupKind: widgetKind, obj: testCustomResource(1, testCondition("Ready", "False", "Progressing")), want: statusInProgress},
+		{name: "custom resource ready for an old generation", groupKind: widgetKind, obj: testCustomResource(2, staleReady), want: statusInProgress},
+		{name: "custom resource reconciling", groupKind: widgetKind, obj: testCustomResource(1, testCondition("Ready", "True", ""), testCondition("Reconciling", "True", "Progressing")), want: statusInProgress},
+		{name: "custom resource stalled", groupKind: widgetKind, obj: testCustomResource(1, testCondition("Ready", "False", ""), testCondition("Stalled", "True", "InvalidSpec")), want: statusFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, message := computeStatus(tt.groupKind, tt.obj)
+			if got != tt.want {
+				t.Errorf("computeStatus() = %s (%s), want %s", got, message, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,310 @@
+package kubernetes
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// defaultContainerAnnotation selects the container kubectl uses when none is given
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// maxLogLineSize is the longest log line that is matched
+const maxLogLineSize = 1024 * 1024
+
+// PodLogsConfig holds the configuration for waiting on pod logs
+type PodLogsConfig struct {
+	Namespace     string        // Namespace of the pods
+	Name          string        // Name of the pod, or empty to use Labels
+	Labels        string        // Label selector of the pods
+	Container     string        // Container to stream, or empty for the default container
+	Pattern       string        // Regular expression a log line must match
+	ErrorPattern  string        // Regular expression of log lines that fail the wait
+	Timeout       time.Duration // Maximum wait time
+	CheckInterval time.Duration // Interval between checks
+}
+
+// PodLogsResult holds the result of a pod logs wait
+type PodLogsResult struct {
+	WaitResult
+	Pod         string // Pod that logged the matching line
+	MatchedLine string // The matching log line
+}
+
+// PodLogsChecker follows the logs of pods until a line matches
+type PodLogsChecker struct {
+	Client *Client
+	Config *PodLogsConfig
+
+	started      time.Time
+	pattern      *regexp.Regexp
+	errorPattern *regexp.Regexp
+	streamCtx    context.Context
+	lines        chan logLine
+	streams      map[types.UID]int32 // Restart count of the container when its stream started
+	ended        map[types.UID]bool
+	streamErr    error
+	matchedPod   string
+	matchedLine  string
+}
+
+// logLine is reported by a log stream when a line matches or the stream ends
+type logLine struct {
+	uid     types.UID
+	pod     string
+	line    string
+	isError bool
+	ended   bool
+	err     error
+}
+
+// WaitForPodLogs follows the logs of the pods until a line matches the pattern.
+// A line matching the error pattern, or the container terminating first, fails the wait.
+func (c *PodLogsChecker) WaitForPodLogs(ctx context.Context) (*PodLogsResult, error) {
+	var err error
+	if c.pattern, err = regexp.Compile(c.Config.Pattern); err != nil {
+		return nil, fmt.Errorf("invalid pattern: %w", err)
+	}
+	if c.Config.ErrorPattern != "" {
+		if c.errorPattern, err = regexp.Compile(c.Config.ErrorPattern); err != nil {
+			return nil, fmt.Errorf("invalid error pattern: %w", err)
+		}
+	}
+
+	// Streams are stopped once the wait is over
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	c.streamCtx = streamCtx
+	c.started = time.Now()
+	c.lines = make(chan logLine, 16)
+	c.streams = map[types.UID]int32{}
+	c.ended = map[types.UID]bool{}
+
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, c.describePods(), c.CheckPodLogs)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	return &PodLogsResult{
+		WaitResult:  *result,
+		Pod:         c.matchedPod,
+		MatchedLine: c.matchedLine,
+	}, err
+}
+
+// CheckPodLogs reports the lines seen by the log streams, and starts streams for new pods
+func (c *PodLogsChecker) CheckPodLogs(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	for drained := false; !drained; {
+		select {
+		case line := <-c.lines:
+			switch {
+			case line.isError:
+				message := fmt.Sprintf("Pod %s logged an error: %s", line.pod, line.line)
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      message,
+				}, errors.New(message)
+
+			case !line.ended:
+				c.matchedPod = line.pod
+				c.matchedLine = line.line
+				return &WaitResult{
+					ConditionMet: true,
+					LastChecked:  now,
+					Message:      fmt.Sprintf("Pod %s logged: %s", line.pod, line.line),
+				}, nil
+			}
+
+			// A stream that could not be opened is retried, an ended one is
+			// checked against the container state below
+			if line.err != nil {
+				c.streamErr = fmt.Errorf("streaming logs of pod %s: %w", line.pod, line.err)
+				delete(c.streams, line.uid)
+			} else {
+				c.ended[line.uid] = true
+			}
+		default:
+			drained = true
+		}
+	}
+
+	pods, err := c.listPods(ctx)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to get pods: %s", err),
+		}, err
+	}
+
+	var waiting, streaming []string
+	for i := range pods {
+		pod := &pods[i]
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		container := c.containerName(pod)
+		status, found := findContainerStatus(pod, container)
+		if !found {
+			waiting = append(waiting, pod.Name)
+			continue
+		}
+
+		// Pods that finished before the wait started are left over from earlier runs
+		if c.Config.Name == "" && status.State.Terminated != nil && status.State.Terminated.FinishedAt.Time.Before(c.started) {
+			if _, streamed := c.streams[pod.UID]; !streamed {
+				continue
+			}
+		}
+
+		if restartCount, streamed := c.streams[pod.UID]; streamed && c.ended[pod.UID] {
+			if status.State.Terminated != nil || status.RestartCount > restartCount {
+				message := fmt.Sprintf("Container %s of pod %s terminated without logging a line matching %q", container, pod.Name, c.Config.Pattern)
+				if terminated := terminatedState(status); terminated != nil {
+					message = fmt.Sprintf("%s (%s, exit code %d)", message, terminated.Reason, terminated.ExitCode)
+				}
+				return &WaitResult{
+					ConditionMet: false,
+					LastChecked:  now,
+					Message:      message,
+				}, errors.New(message)
+			}
+
+			// The connection was dropped while the container is still running
+			delete(c.streams, pod.UID)
+			delete(c.ended, pod.UID)
+		}
+
+		if status.State.Running == nil && status.State.Terminated == nil {
+			waiting = append(waiting, pod.Name)
+			continue
+		}
+		if _, streamed := c.streams[pod.UID]; !streamed {
+			c.streams[pod.UID] = status.RestartCount
+			go c.streamLogs(pod.Name, pod.UID, container)
+		}
+		streaming = append(streaming, pod.Name)
+	}
+
+	message := fmt.Sprintf("No line matching %q yet", c.Config.Pattern)
+	switch {
+	case len(pods) == 0:
+		message = fmt.Sprintf("No pods found for %s", c.describePods())
+	case len(streaming) > 0:
+		sort.Strings(streaming)
+		message = fmt.Sprintf("%s in logs of pods: %s", message, strings.Join(streaming, ", "))
+	case len(waiting) > 0:
+		sort.Strings(waiting)
+		message = fmt.Sprintf("Waiting for containers to start in pods: %s", strings.Join(waiting, ", "))
+	}
+	if c.streamErr != nil {
+		message = fmt.Sprintf("%s, last error: %s", message, c.streamErr)
+	}
+
+	return &WaitResult{
+		ConditionMet: false,
+		LastChecked:  now,
+		Message:      message,
+	}, nil
+}
+
+// streamLogs follows the logs of a container, reporting the first matching line or the end of the stream
+func (c *PodLogsChecker) streamLogs(podName string, uid types.UID, container string) {
+	report := func(line logLine) {
+		line.uid = uid
+		line.pod = podName
+		select {
+		case c.lines <- line:
+		case <-c.streamCtx.Done():
+		}
+	}
+
+	stream, err := c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container: container,
+		Follow:    true,
+	}).Stream(c.streamCtx)
+	if err != nil {
+		report(logLine{ended: true, err: err})
+		return
+	}
+	defer stream.Close()
+
+	scanner := bufio.NewScanner(stream)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLogLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if c.errorPattern != nil && c.errorPattern.MatchString(line) {
+			report(logLine{line: line, isError: true})
+			return
+		}
+		if c.pattern.MatchString(line) {
+			report(logLine{line: line})
+			return
+		}
+	}
+
+	if c.streamCtx.Err() != nil {
+		return
+	}
+	report(logLine{ended: true, err: scanner.Err()})
+}
+
+// listPods returns the named pod or the pods matching the label selector
+func (c *PodLogsChecker) listPods(ctx context.Context) ([]corev1.Pod, error) {
+	if c.Config.Name != "" {
+		pod, err := c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).Get(ctx, c.Config.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []corev1.Pod{*pod}, nil
+	}
+
+	podList, err := c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: c.Config.Labels,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
+}
+
+// containerName returns the configured container, or the default container of the pod
+func (c *PodLogsChecker) containerName(pod *corev1.Pod) string {
+	if c.Config.Container != "" {
+		return c.Config.Container
+	}
+	return defaultContainerName(pod)
+}
+
+// describePods describes the pods whose logs are followed
+func (c *PodLogsChecker) describePods() string {
+	if c.Config.Name != "" {
+		return fmt.Sprintf("pod %s/%s", c.Config.Namespace, c.Config.Name)
+	}
+	return fmt.Sprintf("pods %q in namespace %s", c.Config.Labels, c.Config.Namespace)
+}
+
+// terminatedState returns the current or last termination of a container
+func terminatedState(status corev1.ContainerStatus) *corev1.ContainerStateTerminated {
+	if status.State.Terminated != nil {
+		return status.State.Terminated
+	}
+	return status.LastTerminationState.Terminated
+}
@@ -0,0 +1,98 @@
+package kubernetes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// nodeMeetsCondition checks the node conditions beyond status conditions:
+// labels, taints, schedulability, kubelet version and allocatable resources
+func nodeMeetsCondition(node *corev1.Node, conditionType, conditionValue string) (bool, error) {
+	switch conditionType {
+	case "label":
+		// "key" requires the label to exist, "key=value" to have the value
+		key, value, hasValue := strings.Cut(conditionValue, "=")
+		actual, found := node.Labels[key]
+		return found && (!hasValue || actual == value), nil
+
+	case "taint":
+		return nodeHasTaint(node, conditionValue), nil
+
+	case "notaint":
+		// Startup taints like node.cilium.io/agent-not-ready are removed once the node is usable
+		return !nodeHasTaint(node, conditionValue), nil
+
+	case "schedulable":
+		schedulable, err := strconv.ParseBool(conditionValue)
+		if err != nil {
+			return false, fmt.Errorf("schedulable must be 'true' or 'false', got %q", conditionValue)
+		}
+		return !node.Spec.Unschedulable == schedulable, nil
+
+	case "kubelet":
+		return kubeletVersionMatches(node.Status.NodeInfo.KubeletVersion, conditionValue), nil
+
+	case "allocatable":
+		return nodeAllocatableExceeds(node, conditionValue)
+	}
+
+	return false, fmt.Errorf("unsupported node condition type %q", conditionType)
+}
+
+// nodeHasTaint reports whether a node has a taint given as "key" or "key:Effect"
+func nodeHasTaint(node *corev1.Node, taint string) bool {
+	key, effect, hasEffect := strings.Cut(taint, ":")
+	for _, nodeTaint := range node.Spec.Taints {
+		if nodeTaint.Key == key && (!hasEffect || string(nodeTaint.Effect) == effect) {
+			return true
+		}
+	}
+	return false
+}
+
+// kubeletVersionMatches reports whether a kubelet version like "v1.29.3-eks-1234"
+// matches a version or version prefix like "1.29" or "v1.29.3"
+func kubeletVersionMatches(kubeletVersion, expected string) bool {
+	actual := strings.TrimPrefix(kubeletVersion, "v")
+	expected = strings.TrimPrefix(expected, "v")
+	if actual == expected {
+		return true
+	}
+	for _, separator := range []string{".", "-", "+"} {
+		if strings.HasPrefix(actual, expected+separator) {
+			return true
+		}
+	}
+	return false
+}
+
+// nodeAllocatableExceeds checks a threshold like "cpu>=4", "memory>16Gi" or
+// "nvidia.com/gpu>=1" against the allocatable resources of a node
+func nodeAllocatableExceeds(node *corev1.Node, threshold string) (bool, error) {
+	index := strings.Index(threshold, ">")
+	if index <= 0 {
+		return false, fmt.Errorf("allocatable must be in format 'resource>=quantity' or 'resource>quantity', got %q", threshold)
+	}
+
+	name := corev1.ResourceName(strings.TrimSpace(threshold[:index]))
+	value := threshold[index+1:]
+	inclusive := strings.HasPrefix(value, "=")
+	value = strings.TrimSpace(strings.TrimPrefix(value, "="))
+
+	minimum, err := resource.ParseQuantity(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid quantity %q for allocatable %s: %w", value, name, err)
+	}
+
+	// Resources the node does not advertise have no allocatable capacity
+	allocatable := node.Status.Allocatable[name]
+	comparison := allocatable.Cmp(minimum)
+	if inclusive {
+		return comparison >= 0, nil
+	}
+	return comparison > 0, nil
+}
@@ -0,0 +1,86 @@
+package kubernetes
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestKubeletVersionMatches(t *testing.T) {
+	tests := []struct {
+		kubeletVersion string
+		expected       string
+		want           bool
+	}{
+		{kubeletVersion: "v1.29.3", expected: "v1.29.3", want: true},
+		{kubeletVersion: "v1.29.3", expected: "1.29.3", want: true},
+		{kubeletVersion: "v1.29.3-eks-1234", expected: "1.29", want: true},
+		{kubeletVersion: "v1.29.3-eks-1234", expected: "v1.29.3", want: true},
+		{kubeletVersion: "v1.29.3+k3s1", expected: "1.29.3", want: true},
+		{kubeletVersion: "v1.29.3", expected: "1", want: true},
+		{kubeletVersion: "v1.29.3", expected: "1.2", want: false},
+		{kubeletVersion: "v1.29.3", expected: "1.29.", want: false},
+		{kubeletVersion: "v1.29.3", expected: "1.30", want: false},
+		{kubeletVersion: "v1.29.30", expected: "1.29.3", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.kubeletVersion+"/"+tt.expected, func(t *testing.T) {
+			if got := kubeletVersionMatches(tt.kubeletVersion, tt.expected); got != tt.want {
+				t.Errorf("kubeletVersionMatches(%q, %q) = %t, want %t", tt.kubeletVersion, tt.expected, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeAllocatableExceeds(t *testing.T) {
+	node := &corev1.Node{
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("4"),
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+				"nvidia.com/gpu":      resource.MustParse("1"),
+			},
+		},
+	}
+
+	tests := []struct {
+		threshold string
+		want      bool
+		wantErr   bool
+	}{
+		{threshold: "cpu>=4", want: true},
+		{threshold: "cpu>4", want: false},
+		{threshold: "cpu>3500m", want: true},
+		{threshold: "cpu >= 4", want: true},
+		{threshold: "memory>=16Gi", want: true},
+		{threshold: "memory>16Gi", want: false},
+		{threshold: "memory>=17179869184", want: true},
+		{threshold: "nvidia.com/gpu>=1", want: true},
+		{threshold: "nvidia.com/gpu>1", want: false},
+		{threshold: "amd.com/gpu>=1", want: false},
+		{threshold: "amd.com/gpu>=0", want: true},
+		{threshold: "cpu=4", wantErr: true},
+		{threshold: ">=4", wantErr: true},
+		{threshold: "cpu>=four", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.threshold, func(t *testing.T) {
+			got, err := nodeAllocatableExceeds(node, tt.threshold)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %t", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if got != tt.want {
+				t.Errorf("nodeAllocatableExceeds(%q) = %t, want %t", tt.threshold, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// Annotations holding the revision of a ReplicaSet managed by a Deployment or an Argo Rollout
+var replicaSetRevisionAnnotations = []string{"deployment.kubernetes.io/revision", "rollout.argoproj.io/revision"}
+
+// podOwner describes the current pods of a workload
+type podOwner struct {
+	Selector labels.Selector    // Label selector of the owner's pods
+	UIDs     map[types.UID]bool // UIDs of the controllers of the current pods
+
+	// RevisionHash restricts pods to the current revision through their controller-revision-hash label
+	RevisionHash string
+}
+
+// ownsPod reports whether a pod is controlled by the owner and belongs to its current revision
+func (o *podOwner) ownsPod(pod *corev1.Pod) bool {
+	controllerRef := metav1.GetControllerOf(pod)
+	if controllerRef == nil || !o.UIDs[controllerRef.UID] {
+		return false
+	}
+	if o.RevisionHash != "" && pod.Labels[appsv1.ControllerRevisionHashLabelKey] != o.RevisionHash {
+		return false
+	}
+	return true
+}
+
+// listChildPods lists the current pods of the ChildrenOf owner, further
+// filtered by the configured label and field selectors
+func (c *ConditionChecker) listChildPods(ctx context.Context) ([]corev1.Pod, error) {
+	owner, err := c.resolvePodOwner(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	labelSelector := owner.Selector.String()
+	if c.Config.Labels != "" {
+		if labelSelector != "" {
+			labelSelector = labelSelector + ","
+		}
+		labelSelector = labelSelector + c.Config.Labels
+	}
+
+	podList, err := c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labelSelector,
+		FieldSelector: c.Config.FieldSelector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	pods := []corev1.Pod{}
+	for i := range podList.Items {
+		if owner.ownsPod(&podList.Items[i]) {
+			pods = append(pods, podList.Items[i])
+		}
+	}
+	return pods, nil
+}
+
+// resolvePodOwner resolves ChildrenOf ("deployment/my-app", "rollouts.argoproj.io/my-rollout", ...)
+// to the selector, controller UIDs and revision of the owner's current pods
+func (c *ConditionChecker) resolvePodOwner(ctx context.Context) (*podOwner, error) {
+	kind, name, found := strings.Cut(c.Config.ChildrenOf, "/")
+	if !found || kind == "" || name == "" {
+		return nil, fmt.Errorf("children_of must be in format 'kind/name', got %q", c.Config.ChildrenOf)
+	}
+
+	namespace := c.Config.Namespace
+	apps := c.Client.Clientset.AppsV1()
+
+	switch strings.ToLower(kind) {
+	case "deployment", "deployments", "deploy":
+		deployment, err := apps.Deployments(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return c.replicaSetPodOwner(ctx, deployment.UID, deployment.Spec.Selector, deployment.Annotations[replicaSetRevisionAnnotations[0]])
+
+	case "statefulset", "statefulsets", "sts":
+		statefulset, err := apps.StatefulSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		selector, err := metav1.LabelSelectorAsSelector(statefulset.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		return &podOwner{
+			Selector:     selector,
+			UIDs:         map[types.UID]bool{statefulset.UID: true},
+			RevisionHash: statefulset.Status.UpdateRevision,
+		}, nil
+
+	case "daemonset", "daemonsets", "ds":
+		daemonset, err := apps.DaemonSets(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		selector, err := metav1.LabelSelectorAsSelector(daemonset.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		revisionHash, err := c.currentControllerRevisionHash(ctx, namespace, daemonset.UID, selector)
+		if err != nil {
+			return nil, err
+		}
+		return &podOwner{
+			Selector:     selector,
+			UIDs:         map[types.UID]bool{daemonset.UID: true},
+			RevisionHash: revisionHash,
+		}, nil
+
+	case "job", "jobs":
+		job, err := c.Client.Clientset.BatchV1().Jobs(namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+		return &podOwner{
+			Selector: selector,
+			UIDs:     map[types.UID]bool{job.UID: true},
+		}, nil
+	}
+
+	// Any other owner is resolved through the dynamic client as "resource.group/name"
+	resource, group, found := strings.Cut(strings.ToLower(kind), ".")
+	if !found {
+		return nil, fmt.Errorf("unsupported children_of kind %q, use a deployment, statefulset, daemonset, job or 'resource.group/name'", kind)
+	}
+	gvr, err := c.Client.preferredGroupVersionResource(group, resource)
+	if err != nil {
+		return nil, err
+	}
+	obj, err := c.Client.Dynamic.Resource(gvr).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return c.replicaSetPodOwner(ctx, obj.GetUID(), unstructuredLabelSelector(obj), "")
+}
+
+// replicaSetPodOwner resolves an owner whose pods are managed through ReplicaSets,
+// such as a Deployment or an Argo Rollout, to the pods of its current ReplicaSet.
+// The current ReplicaSet has the given revision, or the highest one when empty.
+// Pods controlled by the owner directly are included as well.
+func (c *ConditionChecker) replicaSetPodOwner(ctx context.Context, ownerUID types.UID, labelSelector *metav1.LabelSelector, revision string) (*podOwner, error) {
+	selector := labels.Everything()
+	if labelSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(labelSelector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	replicaSetList, err := c.Client.Clientset.AppsV1().ReplicaSets(c.Config.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var current *appsv1.ReplicaSet
+	currentRevision := int64(-1)
+	for i := range replicaSetList.Items {
+		replicaSet := &replicaSetList.Items[i]
+		controllerRef := metav1.GetControllerOf(replicaSet)
+		if controllerRef == nil || controllerRef.UID != ownerUID {
+			continue
+		}
+
+		revisionNumber := replicaSetRevision(replicaSet)
+		if revision != "" {
+			if strconv.FormatInt(revisionNumber, 10) == revision {
+				current = replicaSet
+				break
+			}
+			continue
+		}
+		if revisionNumber > currentRevision {
+			current = replicaSet
+			currentRevision = revisionNumber
+		}
+	}
+
+	uids := map[types.UID]bool{ownerUID: true}
+	if current != nil {
+		uids[current.UID] = true
+	}
+
+	return &podOwner{
+		Selector: selector,
+		UIDs:     uids,
+	}, nil
+}
+
+// replicaSetRevision returns the revision annotated on a ReplicaSet, or 0
+func replicaSetRevision(replicaSet *appsv1.ReplicaSet) int64 {
+	for _, annotation := range replicaSetRevisionAnnotations {
+		if value, ok := replicaSet.Annotations[annotation]; ok {
+			revision, err := strconv.ParseInt(value, 10, 64)
+			if err == nil {
+				return revision
+			}
+		}
+	}
+	return 0
+}
+
+// currentControllerRevisionHash returns the controller-revision-hash of the
+// newest ControllerRevision of a DaemonSet
+func (c *ConditionChecker) currentControllerRevisionHash(ctx context.Context, namespace string, ownerUID types.UID, selector labels.Selector) (string, error) {
+	revisionList, err := c.Client.Clientset.AppsV1().ControllerRevisions(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var current *appsv1.ControllerRevision
+	for i := range revisionList.Items {
+		revision := &revisionList.Items[i]
+		controllerRef := metav1.GetControllerOf(revision)
+		if controllerRef == nil || controllerRef.UID != ownerUID {
+			continue
+		}
+		if current == nil || revision.Revision > current.Revision {
+			current = revision
+		}
+	}
+
+	if current == nil {
+		return "", nil
+	}
+	return current.Labels[appsv1.ControllerRevisionHashLabelKey], nil
+}
+
+// unstructuredLabelSelector returns spec.selector of an object when it is a label selector
+func unstructuredLabelSelector(obj *unstructured.Unstructured) *metav1.LabelSelector {
+	rawSelector, found, err := unstructured.NestedMap(obj.Object, "spec", "selector")
+	if !found || err != nil {
+		return nil
+	}
+
+	selector := &metav1.LabelSelector{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSelector, selector); err != nil {
+		return nil
+	}
+	return selector
+}
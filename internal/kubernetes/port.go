@@ -0,0 +1,372 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// probeTimeout bounds a single probe over the tunnel
+const probeTimeout = 10 * time.Second
+
+// tcpProbeWait is how long a TCP connection must stay open to count as accepted
+const tcpProbeWait = time.Second
+
+// PortConfig holds the configuration for probing a port through a port-forward
+type PortConfig struct {
+	Namespace     string        // Namespace of the pod or service
+	Pod           string        // Pod to forward to, or empty to use Service
+	Service       string        // Service whose ready pods are forwarded to
+	Port          string        // Port name or number
+	Check         string        // "tcp", "grpc" or "http"
+	GRPCService   string        // Service name of the gRPC health check, empty for the server
+	HTTPPath      string        // Path of the HTTP request
+	HTTPStatus    int           // Expected HTTP status code
+	Timeout       time.Duration // Maximum wait time
+	CheckInterval time.Duration // Interval between checks
+}
+
+// PortResult holds the result of a port wait
+type PortResult struct {
+	WaitResult
+	Pod string // Pod the successful probe was forwarded to
+}
+
+// PortChecker forwards a local port to a pod and probes it
+type PortChecker struct {
+	Client *Client
+	Config *PortConfig
+
+	pod string
+}
+
+// WaitForPort waits until the probe over the port-forward succeeds
+func (c *PortChecker) WaitForPort(ctx context.Context) (*PortResult, error) {
+	switch c.Config.Check {
+	case "tcp", "grpc", "http":
+	default:
+		return nil, fmt.Errorf("unsupported check %q, must be 'tcp', 'grpc' or 'http'", c.Config.Check)
+	}
+
+	description := fmt.Sprintf("%s probe of %s", c.Config.Check, c.describeTarget())
+	result, err := poll(ctx, c.Config.Timeout, c.Config.CheckInterval, description, c.CheckPort)
+	if result == nil {
+		result = &WaitResult{LastChecked: time.Now()}
+	}
+
+	return &PortResult{WaitResult: *result, Pod: c.pod}, err
+}
+
+// CheckPort opens a port-forward to a ready pod and probes it once
+func (c *PortChecker) CheckPort(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	pod, port, reason, err := c.resolveTarget(ctx)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to resolve %s: %s", c.describeTarget(), err),
+		}, err
+	}
+	if reason != "" {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      reason,
+		}, nil
+	}
+
+	forwardErrors := &syncBuffer{}
+	localPort, stop, err := c.forward(ctx, pod, port, forwardErrors)
+	if err != nil {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to forward port %d of pod %s: %s", port, pod, err),
+		}, nil
+	}
+	defer stop()
+
+	address := net.JoinHostPort("127.0.0.1", strconv.Itoa(int(localPort)))
+	var probeErr error
+	switch c.Config.Check {
+	case "tcp":
+		probeErr = probeTCP(address)
+	case "grpc":
+		probeErr = probeGRPC(ctx, address, c.Config.GRPCService)
+	case "http":
+		probeErr = probeHTTP(ctx, address, c.Config.HTTPPath, c.Config.HTTPStatus)
+	}
+
+	if probeErr != nil {
+		message := fmt.Sprintf("%s probe of port %d of pod %s failed: %s", c.Config.Check, port, pod, probeErr)
+		if details := strings.TrimSpace(forwardErrors.String()); details != "" {
+			message = fmt.Sprintf("%s (%s)", message, tailOutput(details))
+		}
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      message,
+		}, nil
+	}
+
+	c.pod = pod
+	return &WaitResult{
+		ConditionMet: true,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("%s probe of port %d of pod %s succeeded", c.Config.Check, port, pod),
+	}, nil
+}
+
+// forward opens a port-forward from a random local port to a port of a pod
+func (c *PortChecker) forward(ctx context.Context, pod string, port int32, errOut io.Writer) (uint16, func(), error) {
+	transport, upgrader, err := spdy.RoundTripperFor(c.Client.Config)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	url := c.Client.Clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(c.Config.Namespace).
+		Name(pod).
+		SubResource("portforward").
+		URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	stopChan := make(chan struct{})
+	readyChan := make(chan struct{})
+	forwarder, err := portforward.NewOnAddresses(dialer, []string{"127.0.0.1"}, []string{fmt.Sprintf("0:%d", port)}, stopChan, readyChan, io.Discard, errOut)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- forwarder.ForwardPorts()
+	}()
+	stop := func() {
+		close(stopChan)
+		<-done
+	}
+
+	select {
+	case <-readyChan:
+	case err := <-done:
+		if err == nil {
+			err = errors.New("port-forward closed")
+		}
+		return 0, nil, err
+	case <-ctx.Done():
+		stop()
+		return 0, nil, ctx.Err()
+	}
+
+	ports, err := forwarder.GetPorts()
+	if err != nil {
+		stop()
+		return 0, nil, err
+	}
+	return ports[0].Local, stop, nil
+}
+
+// resolveTarget returns the pod and container port to forward to. While no
+// pod is available it returns the reason instead.
+func (c *PortChecker) resolveTarget(ctx context.Context) (string, int32, string, error) {
+	if c.Config.Service != "" {
+		return c.resolveServiceTarget(ctx)
+	}
+
+	pod, err := c.Client.Clientset.CoreV1().Pods(c.Config.Namespace).Get(ctx, c.Config.Pod, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", 0, fmt.Sprintf("Pod %s not found in namespace %s", c.Config.Pod, c.Config.Namespace), nil
+	}
+	if err != nil {
+		return "", 0, "", err
+	}
+	if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+		return "", 0, fmt.Sprintf("Pod %s is %s", pod.Name, pod.Status.Phase), nil
+	}
+
+	port, found := containerPort(pod, c.Config.Port)
+	if !found {
+		return "", 0, "", fmt.Errorf("pod %s has no port %s", pod.Name, c.Config.Port)
+	}
+	return pod.Name, port, "", nil
+}
+
+// resolveServiceTarget returns a ready pod behind the service and its target port
+func (c *PortChecker) resolveServiceTarget(ctx context.Context) (string, int32, string, error) {
+	service, err := c.Client.Clientset.CoreV1().Services(c.Config.Namespace).Get(ctx, c.Config.Service, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return "", 0, fmt.Sprintf("Service %s not found in namespace %s", c.Config.Service, c.Config.Namespace), nil
+	}
+	if err != nil {
+		return "", 0, "", err
+	}
+
+	servicePort, found := findServicePort(service, c.Config.Port)
+	if !found {
+		return "", 0, "", fmt.Errorf("service %s has no port %s", service.Name, c.Config.Port)
+	}
+
+	// EndpointSlices resolve named target ports to the port of each pod
+	endpoints, err := c.Client.readyEndpoints(ctx, c.Config.Namespace, service.Name)
+	if err != nil {
+		return "", 0, "", err
+	}
+
+	for _, endpoint := range endpoints {
+		if endpoint.TargetRef == nil || endpoint.TargetRef.Kind != "Pod" {
+			continue
+		}
+		if port, found := endpoint.port(servicePort.Name); found {
+			return endpoint.TargetRef.Name, port, "", nil
+		}
+	}
+
+	return "", 0, fmt.Sprintf("No ready pods behind service %s", service.Name), nil
+}
+
+// describeTarget describes the forwarded pod or service for messages
+func (c *PortChecker) describeTarget() string {
+	if c.Config.Service != "" {
+		return fmt.Sprintf("service %s/%s port %s", c.Config.Namespace, c.Config.Service, c.Config.Port)
+	}
+	return fmt.Sprintf("pod %s/%s port %s", c.Config.Namespace, c.Config.Pod, c.Config.Port)
+}
+
+// findServicePort returns the service port with the given name or number, or
+// the only port of the service when none is given
+func findServicePort(service *corev1.Service, port string) (corev1.ServicePort, bool) {
+	if port == "" && len(service.Spec.Ports) == 1 {
+		return service.Spec.Ports[0], true
+	}
+	for _, servicePort := range service.Spec.Ports {
+		if servicePort.Name == port || strconv.Itoa(int(servicePort.Port)) == port {
+			return servicePort, true
+		}
+	}
+	return corev1.ServicePort{}, false
+}
+
+// containerPort resolves a port number or a named container port of a pod
+func containerPort(pod *corev1.Pod, port string) (int32, bool) {
+	if number, err := strconv.ParseInt(port, 10, 32); err == nil {
+		return int32(number), true
+	}
+	for _, container := range pod.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			if containerPort.Name == port {
+				return containerPort.ContainerPort, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// probeTCP connects through the tunnel. The local listener always accepts, so
+// the connection must also stay open: the tunnel closes it when the pod refuses.
+func probeTCP(address string) error {
+	conn, err := net.DialTimeout("tcp", address, probeTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetReadDeadline(time.Now().Add(tcpProbeWait)); err != nil {
+		return err
+	}
+	buffer := make([]byte, 1)
+	if _, err := conn.Read(buffer); err != nil {
+		var netErr net.Error
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			// Still open, the server waits for the client to speak first
+			return nil
+		}
+		return fmt.Errorf("connection closed: %w", err)
+	}
+	return nil
+}
+
+// probeGRPC calls grpc.health.v1.Health/Check and requires SERVING
+func probeGRPC(ctx context.Context, address, service string) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	response, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+	if err != nil {
+		return err
+	}
+	if response.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("status %s", response.Status)
+	}
+	return nil
+}
+
+// probeHTTP sends a GET request through the tunnel and checks the status code
+func probeHTTP(ctx context.Context, address, path string, status int) error {
+	ctx, cancel := context.WithTimeout(ctx, probeTimeout)
+	defer cancel()
+
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("http://%s%s", address, path), nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != status {
+		body, _ := io.ReadAll(io.LimitReader(response.Body, maxReportedOutput))
+		return fmt.Errorf("expected status %d, got %d: %s", status, response.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// syncBuffer collects the error output of a port-forward, which is written from other goroutines
+type syncBuffer struct {
+	mu     sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buffer.String()
+}
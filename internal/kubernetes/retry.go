@@ -0,0 +1,132 @@
+package kubernetes
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
+)
+
+const (
+	// initialRetryDelay is the first delay used after a transient API error
+	initialRetryDelay = 1 * time.Second
+	// maxRetryDelay caps the exponential backoff between transient API errors
+	maxRetryDelay = 30 * time.Second
+)
+
+// transientErrorMessages are substrings of errors that the API server (or the
+// path to it) returns while it is booting or electing a new etcd leader
+var transientErrorMessages = []string{
+	"etcdserver: leader changed",
+	"etcdserver: request timed out",
+	"etcdserver: no leader",
+	"the server is currently unable to handle the request",
+	"the server has received too many requests",
+	"tls: handshake",
+	"remote error: tls",
+	"connection reset by peer",
+	"no route to host",
+	"i/o timeout",
+	"TLS handshake timeout",
+}
+
+// isRetryableError reports whether err is a transient API error worth retrying,
+// and the delay the server asked for (zero if it did not suggest one)
+func isRetryableError(err error) (bool, time.Duration) {
+	if err == nil {
+		return false, 0
+	}
+
+	// Errors that will not go away by waiting: bad credentials, missing
+	// permissions, or a resource type the API server does not serve
+	if apierrors.IsUnauthorized(err) || apierrors.IsForbidden(err) || meta.IsNoMatchError(err) ||
+		apierrors.IsBadRequest(err) || apierrors.IsInvalid(err) || apierrors.IsMethodNotSupported(err) {
+		return false, 0
+	}
+
+	// A missing object may still be created, while a 404 without object
+	// details means the resource type itself is not served
+	if apierrors.IsNotFound(err) {
+		return isObjectNotFound(err), 0
+	}
+
+	var retryAfter time.Duration
+	if seconds, ok := apierrors.SuggestsClientDelay(err); ok && seconds > 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	}
+
+	if apierrors.IsTooManyRequests(err) || apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) ||
+		apierrors.IsServiceUnavailable(err) || apierrors.IsInternalError(err) {
+		return true, retryAfter
+	}
+
+	var statusErr apierrors.APIStatus
+	if errors.As(err, &statusErr) && statusErr.Status().Code >= http.StatusInternalServerError {
+		return true, retryAfter
+	}
+
+	if utilnet.IsConnectionRefused(err) || utilnet.IsConnectionReset(err) || utilnet.IsProbableEOF(err) ||
+		utilnet.IsTimeout(err) || utilnet.IsHTTP2ConnectionLost(err) || errors.Is(err, syscall.EHOSTUNREACH) {
+		return true, retryAfter
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true, retryAfter
+	}
+
+	// The API server endpoint of a cluster created in the same apply is often
+	// not resolvable yet
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true, retryAfter
+	}
+
+	var recordErr tls.RecordHeaderError
+	if errors.As(err, &recordErr) {
+		return true, retryAfter
+	}
+
+	message := err.Error()
+	for _, transient := range transientErrorMessages {
+		if strings.Contains(message, transient) {
+			return true, retryAfter
+		}
+	}
+
+	return false, 0
+}
+
+// isObjectNotFound reports whether a NotFound error names a missing object
+func isObjectNotFound(err error) bool {
+	var statusErr apierrors.APIStatus
+	if !errors.As(err, &statusErr) {
+		return false
+	}
+	details := statusErr.Status().Details
+	return details != nil && details.Name != ""
+}
+
+// nextRetryDelay returns the delay before the next attempt after a transient
+// error, doubling the previous delay up to maxRetryDelay. A server-suggested
+// delay takes precedence when it is longer.
+func nextRetryDelay(previous, suggested time.Duration) time.Duration {
+	delay := initialRetryDelay
+	if previous > 0 {
+		delay = previous * 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	if suggested > delay {
+		delay = suggested
+	}
+	return delay
+}
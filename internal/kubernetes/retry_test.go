@@ -0,0 +1,90 @@
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	deployments := schema.GroupResource{Group: "apps", Resource: "deployments"}
+	resourceNotServed := &apierrors.StatusError{ErrStatus: metav1.Status{
+		Status: metav1.StatusFailure,
+		Code:   http.StatusNotFound,
+		Reason: metav1.StatusReasonNotFound,
+	}}
+	connectionRefused := &net.OpError{Op: "dial", Net: "tcp", Err: &os.SyscallError{Syscall: "connect", Err: syscall.ECONNREFUSED}}
+
+	tests := []struct {
+		name           string
+		err            error
+		want           bool
+		wantRetryAfter time.Duration
+	}{
+		{name: "no error", err: nil, want: false},
+		{name: "unauthorized", err: apierrors.NewUnauthorized("invalid token"), want: false},
+		{name: "forbidden", err: apierrors.NewForbidden(deployments, "web", errors.New("no RBAC")), want: false},
+		{name: "object not found", err: apierrors.NewNotFound(deployments, "web"), want: true},
+		{name: "resource type not served", err: resourceNotServed, want: false},
+		{name: "no kind match", err: &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "example.com", Kind: "Widget"}}, want: false},
+		{name: "bad request", err: apierrors.NewBadRequest("invalid selector"), want: false},
+		{name: "too many requests", err: apierrors.NewTooManyRequests("slow down", 5), want: true, wantRetryAfter: 5 * time.Second},
+		{name: "service unavailable", err: apierrors.NewServiceUnavailable("starting"), want: true},
+		{name: "internal error", err: apierrors.NewInternalError(errors.New("etcd")), want: true},
+		{name: "server timeout", err: apierrors.NewServerTimeout(deployments, "list", 2), want: true, wantRetryAfter: 2 * time.Second},
+		{name: "bad gateway", err: apierrors.NewGenericServerResponse(http.StatusBadGateway, "get", deployments, "web", "", 0, true), want: true},
+		{name: "wrapped service unavailable", err: fmt.Errorf("listing deployments: %w", apierrors.NewServiceUnavailable("starting")), want: true},
+		{name: "connection refused", err: connectionRefused, want: true},
+		{name: "DNS not resolvable yet", err: &net.DNSError{Err: "no such host", Name: "api.example.com", IsNotFound: true}, want: true},
+		{name: "etcd leader change", err: errors.New("etcdserver: leader changed"), want: true},
+		{name: "TLS handshake timeout", err: errors.New("net/http: TLS handshake timeout"), want: true},
+		{name: "other error", err: errors.New("unsupported condition"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, retryAfter := isRetryableError(tt.err)
+			if got != tt.want {
+				t.Errorf("isRetryableError(%v) = %t, want %t", tt.err, got, tt.want)
+			}
+			if retryAfter != tt.wantRetryAfter {
+				t.Errorf("isRetryableError(%v) retry after %s, want %s", tt.err, retryAfter, tt.wantRetryAfter)
+			}
+		})
+	}
+}
+
+func TestNextRetryDelay(t *testing.T) {
+	tests := []struct {
+		previous  time.Duration
+		suggested time.Duration
+		want      time.Duration
+	}{
+		{previous: 0, want: initialRetryDelay},
+		{previous: time.Second, want: 2 * time.Second},
+		{previous: 8 * time.Second, want: 16 * time.Second},
+		{previous: 20 * time.Second, want: maxRetryDelay},
+		{previous: maxRetryDelay, want: maxRetryDelay},
+		{previous: time.Second, suggested: 10 * time.Second, want: 10 * time.Second},
+		{previous: 8 * time.Second, suggested: 5 * time.Second, want: 16 * time.Second},
+		{previous: 0, suggested: 45 * time.Second, want: 45 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/%s", tt.previous, tt.suggested), func(t *testing.T) {
+			if got := nextRetryDelay(tt.previous, tt.suggested); got != tt.want {
+				t.Errorf("nextRetryDelay(%s, %s) = %s, want %s", tt.previous, tt.suggested, got, tt.want)
+			}
+		})
+	}
+}
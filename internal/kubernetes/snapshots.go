@@ -0,0 +1,69 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var volumeSnapshotsGVR = schema.GroupVersionResource{Group: "snapshot.storage.k8s.io", Version: "v1", Resource: "volumesnapshots"}
+
+// snapshotSource returns the namespace and name of the VolumeSnapshot a claim
+// is restored from, or an empty name if it has no snapshot data source
+func snapshotSource(pvc *corev1.PersistentVolumeClaim) (string, string) {
+	isSnapshot := func(apiGroup *string, kind string) bool {
+		return apiGroup != nil && *apiGroup == volumeSnapshotsGVR.Group && kind == "VolumeSnapshot"
+	}
+
+	// dataSourceRef supersedes dataSource and may point to another namespace
+	if ref := pvc.Spec.DataSourceRef; ref != nil {
+		if !isSnapshot(ref.APIGroup, ref.Kind) {
+			return "", ""
+		}
+		if ref.Namespace != nil && *ref.Namespace != "" {
+			return *ref.Namespace, ref.Name
+		}
+		return pvc.Namespace, ref.Name
+	}
+	if ref := pvc.Spec.DataSource; ref != nil && isSnapshot(ref.APIGroup, ref.Kind) {
+		return pvc.Namespace, ref.Name
+	}
+	return "", ""
+}
+
+// pendingSnapshot describes why the VolumeSnapshot a Pending claim is restored
+// from holds up provisioning, or returns an empty string if it is ready to use
+// or the claim is not restored from a snapshot
+func (c *ConditionChecker) pendingSnapshot(ctx context.Context, pvc *corev1.PersistentVolumeClaim) (string, error) {
+	namespace, name := snapshotSource(pvc)
+	if name == "" {
+		return "", nil
+	}
+
+	snapshot, err := c.Client.Dynamic.Resource(volumeSnapshotsGVR).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if isObjectNotFound(err) {
+			return fmt.Sprintf("%s: volumesnapshot %s/%s not found", pvc.Name, namespace, name), nil
+		}
+		// The snapshot only adds detail to the message, waiting for the claim
+		// must not need access to snapshots or the snapshot CRDs being installed
+		if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return "", nil
+		}
+		return "", err
+	}
+
+	if readyToUse, _, _ := unstructured.NestedBool(snapshot.Object, "status", "readyToUse"); readyToUse {
+		return "", nil
+	}
+	if message, _, _ := unstructured.NestedString(snapshot.Object, "status", "error", "message"); message != "" {
+		return fmt.Sprintf("%s: volumesnapshot %s/%s is not ready to use: %s", pvc.Name, namespace, name, message), nil
+	}
+	return fmt.Sprintf("%s: volumesnapshot %s/%s is not ready to use yet", pvc.Name, namespace, name), nil
+}
@@ -0,0 +1,58 @@
+package kubernetes
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func stringPtr(value string) *string {
+	return &value
+}
+
+func TestSnapshotSource(t *testing.T) {
+	snapshotGroup := stringPtr("snapshot.storage.k8s.io")
+
+	tests := []struct {
+		name          string
+		spec          corev1.PersistentVolumeClaimSpec
+		wantNamespace string
+		wantName      string
+	}{
+		{name: "no data source"},
+		{
+			name:          "snapshot data source",
+			spec:          corev1.PersistentVolumeClaimSpec{DataSource: &corev1.TypedLocalObjectReference{APIGroup: snapshotGroup, Kind: "VolumeSnapshot", Name: "nightly"}},
+			wantNamespace: "database",
+			wantName:      "nightly",
+		},
+		{
+			name:          "snapshot data source ref in another namespace",
+			spec:          corev1.PersistentVolumeClaimSpec{DataSourceRef: &corev1.TypedObjectReference{APIGroup: snapshotGroup, Kind: "VolumeSnapshot", Name: "nightly", Namespace: stringPtr("backups")}},
+			wantNamespace: "backups",
+			wantName:      "nightly",
+		},
+		{
+			name: "data source ref takes precedence",
+			spec: corev1.PersistentVolumeClaimSpec{
+				DataSource:    &corev1.TypedLocalObjectReference{APIGroup: snapshotGroup, Kind: "VolumeSnapshot", Name: "nightly"},
+				DataSourceRef: &corev1.TypedObjectReference{APIGroup: stringPtr("populators.example.com"), Kind: "Populator", Name: "seed"},
+			},
+		},
+		{
+			name: "cloned claim",
+			spec: corev1.PersistentVolumeClaimSpec{DataSource: &corev1.TypedLocalObjectReference{Kind: "PersistentVolumeClaim", Name: "original"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "database"}, Spec: tt.spec}
+			namespace, name := snapshotSource(pvc)
+			if namespace != tt.wantNamespace || name != tt.wantName {
+				t.Errorf("snapshotSource() = %q, %q, want %q, %q", namespace, name, tt.wantNamespace, tt.wantName)
+			}
+		})
+	}
+}
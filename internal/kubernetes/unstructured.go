@@ -0,0 +1,64 @@
+package kubernetes
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// unstructuredCondition is a status condition read from an unstructured object
+type unstructuredCondition struct {
+	Type               string
+	Status             string
+	Reason             string
+	Message            string
+	ObservedGeneration int64
+}
+
+// isCurrent reports whether the condition was observed for the given object
+// generation. Conditions without an observedGeneration are assumed current.
+func (condition unstructuredCondition) isCurrent(generation int64) bool {
+	return condition.ObservedGeneration == 0 || condition.ObservedGeneration >= generation
+}
+
+// getUnstructuredConditions reads the conditions found at the given field path
+// (e.g., "status", "conditions") of an unstructured object
+func getUnstructuredConditions(obj map[string]interface{}, fields ...string) []unstructuredCondition {
+	rawConditions, found, err := unstructured.NestedSlice(obj, fields...)
+	if err != nil || !found {
+		return nil
+	}
+
+	conditions := []unstructuredCondition{}
+	for _, rawCondition := range rawConditions {
+		condition, ok := rawCondition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		conditionType, _, _ := unstructured.NestedString(condition, "type")
+		status, _, _ := unstructured.NestedString(condition, "status")
+		reason, _, _ := unstructured.NestedString(condition, "reason")
+		message, _, _ := unstructured.NestedString(condition, "message")
+		observedGeneration, _, _ := unstructured.NestedInt64(condition, "observedGeneration")
+
+		conditions = append(conditions, unstructuredCondition{
+			Type:               conditionType,
+			Status:             status,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: observedGeneration,
+		})
+	}
+
+	return conditions
+}
+
+// findUnstructuredCondition returns the condition with the given type from
+// status.conditions of an unstructured object
+func findUnstructuredCondition(obj *unstructured.Unstructured, conditionType string) (unstructuredCondition, bool) {
+	for _, condition := range getUnstructuredConditions(obj.Object, "status", "conditions") {
+		if condition.Type == conditionType {
+			return condition, true
+		}
+	}
+	return unstructuredCondition{}, false
+}
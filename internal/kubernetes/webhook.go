@@ -0,0 +1,201 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// WebhookConfig holds the configuration for waiting on an admission webhook
+type WebhookConfig struct {
+	Name           string        // Name of the Validating/MutatingWebhookConfiguration
+	Type           string        // "validating" or "mutating"
+	DryRunManifest string        // Sample object (YAML or JSON) to dry-run create, empty to skip
+	Timeout        time.Duration // Maximum wait time
+	CheckInterval  time.Duration // Interval between checks
+}
+
+// WebhookChecker waits for an admission webhook configuration to be able to answer requests
+type WebhookChecker struct {
+	Client *Client
+	Config *WebhookConfig
+}
+
+// webhookClientConfig is the part of a validating or mutating webhook that is checked
+type webhookClientConfig struct {
+	Name         string
+	ClientConfig admissionregistrationv1.WebhookClientConfig
+}
+
+// WaitForWebhook waits until the webhooks of the configuration are ready
+func (c *WebhookChecker) WaitForWebhook(ctx context.Context) (*WaitResult, error) {
+	return poll(ctx, c.Config.Timeout, c.Config.CheckInterval, fmt.Sprintf("%s webhook %s ready", c.Config.Type, c.Config.Name), c.CheckWebhook)
+}
+
+// CheckWebhook performs a single check of the webhook configuration
+func (c *WebhookChecker) CheckWebhook(ctx context.Context) (*WaitResult, error) {
+	now := time.Now()
+
+	webhooks, err := c.getWebhooks(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("%s webhook configuration %s not found", c.Config.Type, c.Config.Name),
+			}, nil
+		}
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Failed to get %s webhook configuration: %s", c.Config.Type, err),
+		}, err
+	}
+
+	problems := []string{}
+	for _, webhook := range webhooks {
+		service := webhook.ClientConfig.Service
+
+		// Webhooks called by URL may rely on the system trust roots
+		if service == nil {
+			continue
+		}
+
+		if len(webhook.ClientConfig.CABundle) == 0 {
+			problems = append(problems, fmt.Sprintf("%s: caBundle not injected", webhook.Name))
+		}
+
+		addresses, err := c.Client.readyEndpointAddresses(ctx, service.Namespace, service.Name)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Failed to list endpoints of service %s/%s: %s", service.Namespace, service.Name, err),
+			}, err
+		}
+		if len(addresses) == 0 {
+			problems = append(problems, fmt.Sprintf("%s: service %s/%s has no ready endpoints", webhook.Name, service.Namespace, service.Name))
+		}
+	}
+
+	if len(problems) > 0 {
+		return &WaitResult{
+			ConditionMet: false,
+			LastChecked:  now,
+			Message:      fmt.Sprintf("Webhooks not ready: %s", strings.Join(problems, "; ")),
+		}, nil
+	}
+
+	if c.Config.DryRunManifest != "" {
+		answered, message, err := c.dryRunCreate(ctx)
+		if err != nil {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      fmt.Sprintf("Dry-run create failed: %s", err),
+			}, err
+		}
+		if !answered {
+			return &WaitResult{
+				ConditionMet: false,
+				LastChecked:  now,
+				Message:      message,
+			}, nil
+		}
+	}
+
+	return &WaitResult{
+		ConditionMet: true,
+		LastChecked:  now,
+		Message:      fmt.Sprintf("%d webhooks of %s webhook configuration %s are ready", len(webhooks), c.Config.Type, c.Config.Name),
+	}, nil
+}
+
+// getWebhooks returns the webhooks of the validating or mutating configuration
+func (c *WebhookChecker) getWebhooks(ctx context.Context) ([]webhookClientConfig, error) {
+	webhooks := []webhookClientConfig{}
+
+	switch strings.ToLower(c.Config.Type) {
+	case "mutating":
+		configuration, err := c.Client.Clientset.AdmissionregistrationV1().MutatingWebhookConfigurations().Get(ctx, c.Config.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, webhook := range configuration.Webhooks {
+			webhooks = append(webhooks, webhookClientConfig{Name: webhook.Name, ClientConfig: webhook.ClientConfig})
+		}
+	case "validating", "":
+		configuration, err := c.Client.Clientset.AdmissionregistrationV1().ValidatingWebhookConfigurations().Get(ctx, c.Config.Name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, webhook := range configuration.Webhooks {
+			webhooks = append(webhooks, webhookClientConfig{Name: webhook.Name, ClientConfig: webhook.ClientConfig})
+		}
+	default:
+		return nil, fmt.Errorf("unsupported webhook type %q, must be 'validating' or 'mutating'", c.Config.Type)
+	}
+
+	return webhooks, nil
+}
+
+// dryRunCreate performs a server-side dry-run create of the sample object to
+// prove the webhooks answer. A webhook denying the sample object still counts
+// as answered; only failures to call the webhook are reported as not ready.
+func (c *WebhookChecker) dryRunCreate(ctx context.Context) (bool, string, error) {
+	obj := &unstructured.Unstructured{}
+	if err := yaml.Unmarshal([]byte(c.Config.DryRunManifest), &obj.Object); err != nil {
+		return false, "", fmt.Errorf("invalid dry-run manifest: %w", err)
+	}
+
+	gvr, namespaced, err := c.Client.resourceForKind(obj.GroupVersionKind())
+	if err != nil {
+		return false, "", err
+	}
+
+	resourceClient := c.Client.Dynamic.Resource(gvr)
+	var createErr error
+	if namespaced {
+		namespace := obj.GetNamespace()
+		if namespace == "" {
+			namespace = "default"
+		}
+		_, createErr = resourceClient.Namespace(namespace).Create(ctx, obj, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	} else {
+		_, createErr = resourceClient.Create(ctx, obj, metav1.CreateOptions{DryRun: []string{metav1.DryRunAll}})
+	}
+
+	var statusErr *apierrors.StatusError
+	switch {
+	case createErr == nil, apierrors.IsAlreadyExists(createErr):
+		return true, "", nil
+	case apierrors.IsInternalError(createErr), apierrors.IsTimeout(createErr), apierrors.IsServerTimeout(createErr):
+		// The API server reports webhooks it failed to call as internal errors
+		return false, fmt.Sprintf("Webhook did not answer the dry-run create: %s", createErr), nil
+	case errors.As(createErr, &statusErr) && isWebhookDenial(statusErr):
+		return true, "", nil
+	default:
+		return false, "", createErr
+	}
+}
+
+// isWebhookDenial reports whether a status error is a webhook rejecting the
+// object. Rejections carry the status returned by the webhook, without the
+// details of the object that the API server adds to its own errors.
+func isWebhookDenial(err *apierrors.StatusError) bool {
+	status := err.ErrStatus
+	if status.Code < http.StatusBadRequest || status.Code >= http.StatusInternalServerError ||
+		status.Code == http.StatusUnauthorized || status.Code == http.StatusTooManyRequests {
+		return false
+	}
+	return status.Details == nil || (status.Details.Kind == "" && status.Details.Name == "")
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DataWaitResource is the shared implementation of the Secret and ConfigMap resources
+type DataWaitResource struct {
+	BaseWaitResource
+	kind string
+}
+
+// DataWaitResourceModel describes the data model of the Secret and ConfigMap resources.
+type DataWaitResourceModel struct {
+	// Object and keys
+	Name         types.String `tfsdk:"name"`
+	Namespace    types.String `tfsdk:"namespace"`
+	RequiredKeys types.List   `tfsdk:"required_keys"`
+	Match        types.Map    `tfsdk:"match"`
+	ExposeKeys   types.List   `tfsdk:"expose_keys"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+	Values       types.Map    `tfsdk:"values"`
+}
+
+// GetDataSchema returns the schema of the Secret and ConfigMap resources
+func GetDataSchema(objectName, description string) schema.Schema {
+	attributes := GetBaseAttributes()
+
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Name of the %s", objectName),
+		Required:            true,
+	}
+	attributes["namespace"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Namespace of the %s. Defaults to 'default'.", objectName),
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("default"),
+	}
+	attributes["required_keys"] = schema.ListAttribute{
+		MarkdownDescription: "Keys that must exist with non-empty values.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+	attributes["match"] = schema.MapAttribute{
+		MarkdownDescription: "Regular expressions, by key, that the values must match. The keys are required.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+	attributes["expose_keys"] = schema.ListAttribute{
+		MarkdownDescription: "Keys whose values are exposed in `values`. The keys are required.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+	attributes["values"] = schema.MapAttribute{
+		MarkdownDescription: "Values of the keys listed in `expose_keys`.",
+		ElementType:         types.StringType,
+		Computed:            true,
+		Sensitive:           true,
+	}
+
+	return schema.Schema{
+		MarkdownDescription: description,
+		Attributes:          attributes,
+	}
+}
+
+func (r *DataWaitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DataWaitResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	requiredKeys := []string{}
+	if !data.RequiredKeys.IsNull() && !data.RequiredKeys.IsUnknown() {
+		resp.Diagnostics.Append(data.RequiredKeys.ElementsAs(ctx, &requiredKeys, false)...)
+	}
+	patterns := map[string]string{}
+	if !data.Match.IsNull() && !data.Match.IsUnknown() {
+		resp.Diagnostics.Append(data.Match.ElementsAs(ctx, &patterns, false)...)
+	}
+	exposeKeys := []string{}
+	if !data.ExposeKeys.IsNull() && !data.ExposeKeys.IsUnknown() {
+		resp.Diagnostics.Append(data.ExposeKeys.ElementsAs(ctx, &exposeKeys, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	dataChecker := &kubernetes.DataChecker{
+		Client: client,
+		Config: &kubernetes.DataConfig{
+			Kind:          r.kind,
+			Name:          data.Name.ValueString(),
+			Namespace:     r.getNamespaceValue(data.Namespace.ValueString()),
+			RequiredKeys:  requiredKeys,
+			Patterns:      patterns,
+			ExposeKeys:    exposeKeys,
+			Timeout:       time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval: time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	result, err := dataChecker.WaitForData(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	values, diags := types.MapValueFrom(ctx, types.StringType, result.Values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.Values = values
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataWaitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DataWaitResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DataWaitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *DataWaitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
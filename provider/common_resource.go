@@ -7,6 +7,7 @@ import (
 
 	"nuxij/kubewait/internal/kubernetes"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -21,12 +22,12 @@ type BaseWaitResource struct {
 	resourceType   string
 }
 
-// GenericWaitResourceModel extends BaseWaitResourceModel with the resource field for generic waiting
-type GenericWaitResourceModel struct {
+// BaseWaitResourceModel holds the attributes shared by every condition wait resource.
+// The models of the specific resources embed it and add their own options.
+type BaseWaitResourceModel struct {
 	// Common wait attributes
 	For           types.String `tfsdk:"for"`
 	Name          types.String `tfsdk:"name"`
-	Namespace     types.String `tfsdk:"namespace"`
 	All           types.Bool   `tfsdk:"all"`
 	Timeout       types.Int64  `tfsdk:"timeout"`
 	CheckInterval types.Int64  `tfsdk:"check_interval"`
@@ -39,7 +40,7 @@ type GenericWaitResourceModel struct {
 	KubeConfig     types.String `tfsdk:"kube_config"`
 	Context        types.String `tfsdk:"context"`
 
-	// Resource field for generic wait resource (optional, omitted from specific resource schemas)
+	// Resource field (required for the generic wait resource, auto-populated for specific resources)
 	Resource types.String `tfsdk:"resource"`
 
 	// Computed attributes
@@ -49,32 +50,95 @@ type GenericWaitResourceModel struct {
 	Message      types.String `tfsdk:"message"`
 }
 
+// GenericWaitResourceModel extends BaseWaitResourceModel with the namespace of namespaced resources
+type GenericWaitResourceModel struct {
+	BaseWaitResourceModel
+	Namespace types.String `tfsdk:"namespace"`
+}
+
 // ClusterScopedWaitResourceModel for cluster-scoped resources (like nodes) that don't have namespaces
 type ClusterScopedWaitResourceModel struct {
-	// Common wait attributes
-	For  types.String `tfsdk:"for"`
-	Name types.String `tfsdk:"name"`
-	// No namespace field for cluster-scoped resources
-	All           types.Bool   `tfsdk:"all"`
-	Timeout       types.Int64  `tfsdk:"timeout"`
-	CheckInterval types.Int64  `tfsdk:"check_interval"`
-	CheckOnce     types.Bool   `tfsdk:"check_once"`
-	Labels        types.String `tfsdk:"labels"`
-	FieldSelector types.String `tfsdk:"field_selector"`
+	BaseWaitResourceModel
+}
 
-	// Authentication config
-	KubeConfigType types.String `tfsdk:"kube_config_type"`
-	KubeConfig     types.String `tfsdk:"kube_config"`
-	Context        types.String `tfsdk:"context"`
+// AddressWaitResourceModel for namespaced resources that are assigned addresses (like services and ingresses)
+type AddressWaitResourceModel struct {
+	GenericWaitResourceModel
+	IPs       types.List `tfsdk:"ips"`
+	Hostnames types.List `tfsdk:"hostnames"`
+}
 
-	// Resource field (auto-populated for specific resources)
-	Resource types.String `tfsdk:"resource"`
+// WorkloadWaitResourceModel for workloads that run pods (like deployments and statefulsets)
+type WorkloadWaitResourceModel struct {
+	GenericWaitResourceModel
 
-	// Computed attributes
-	ID           types.String `tfsdk:"id"`
-	ConditionMet types.Bool   `tfsdk:"condition_met"`
-	LastChecked  types.String `tfsdk:"last_checked"`
-	Message      types.String `tfsdk:"message"`
+	// Image verification of the workload's pods
+	Images              types.Map  `tfsdk:"images"`
+	CompareImageDigests types.Bool `tfsdk:"compare_image_digests"`
+}
+
+// PodWaitResourceModel for pods, with pod-specific wait options
+type PodWaitResourceModel struct {
+	WorkloadWaitResourceModel
+
+	// Pod-specific options
+	MaxRestarts types.Int64  `tfsdk:"max_restarts"`
+	ChildrenOf  types.String `tfsdk:"children_of"`
+}
+
+// CronJobWaitResourceModel for cronjobs, with the option to trigger a run
+type CronJobWaitResourceModel struct {
+	GenericWaitResourceModel
+
+	// CronJob-specific options
+	Trigger types.Bool `tfsdk:"trigger"`
+}
+
+// waitResourceModel is implemented by every model embedding BaseWaitResourceModel
+type waitResourceModel interface {
+	base() *BaseWaitResourceModel
+	namespace() string
+	applyOptions(ctx context.Context, config *kubernetes.WaitConfig) diag.Diagnostics
+}
+
+func (m *BaseWaitResourceModel) base() *BaseWaitResourceModel {
+	return m
+}
+
+// namespace returns an empty namespace, cluster-scoped resources don't have one
+func (m *BaseWaitResourceModel) namespace() string {
+	return ""
+}
+
+// applyOptions sets the options of the specific resources on the wait config
+func (m *BaseWaitResourceModel) applyOptions(ctx context.Context, config *kubernetes.WaitConfig) diag.Diagnostics {
+	return nil
+}
+
+func (m *GenericWaitResourceModel) namespace() string {
+	return m.Namespace.ValueString()
+}
+
+func (m *WorkloadWaitResourceModel) applyOptions(ctx context.Context, config *kubernetes.WaitConfig) diag.Diagnostics {
+	diags := m.Images.ElementsAs(ctx, &config.Images, false)
+	config.CompareImageDigests = m.CompareImageDigests.ValueBool()
+	return diags
+}
+
+func (m *PodWaitResourceModel) applyOptions(ctx context.Context, config *kubernetes.WaitConfig) diag.Diagnostics {
+	diags := m.WorkloadWaitResourceModel.applyOptions(ctx, config)
+
+	if !m.MaxRestarts.IsNull() && !m.MaxRestarts.IsUnknown() {
+		maxRestarts := int32(m.MaxRestarts.ValueInt64())
+		config.MaxRestarts = &maxRestarts
+	}
+	config.ChildrenOf = m.ChildrenOf.ValueString()
+	return diags
+}
+
+func (m *CronJobWaitResourceModel) applyOptions(ctx context.Context, config *kubernetes.WaitConfig) diag.Diagnostics {
+	config.Trigger = m.Trigger.ValueBool()
+	return nil
 }
 
 // ResourceConfig defines resource-specific configuration
@@ -83,6 +147,8 @@ type ResourceConfig struct {
 	Description      string
 	ForDescription   string
 	IncludeNamespace bool
+	IncludeAddresses bool
+	IncludeImages    bool
 }
 
 // Configure implements resource.Resource.
@@ -103,19 +169,10 @@ func (r *BaseWaitResource) Configure(ctx context.Context, req resource.Configure
 	r.providerConfig = providerConfig
 }
 
-// GetCommonSchema returns the common schema attributes for wait resources
-func GetCommonSchema(config ResourceConfig) schema.Schema {
-	attributes := map[string]schema.Attribute{
-		"for": schema.StringAttribute{
-			MarkdownDescription: config.ForDescription,
-			Required:            true,
-		},
-		"all": schema.BoolAttribute{
-			MarkdownDescription: fmt.Sprintf("Wait for all matching %s (true) or just one (false). Defaults to false.", config.TypeName),
-			Optional:            true,
-			Computed:            true,
-			Default:             booldefault.StaticBool(false),
-		},
+// GetBaseAttributes returns the timing, authentication and computed attributes
+// shared by every wait resource
+func GetBaseAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
 		"timeout": schema.Int64Attribute{
 			MarkdownDescription: "Maximum time to wait in seconds. Defaults to 300.",
 			Optional:            true,
@@ -134,14 +191,6 @@ func GetCommonSchema(config ResourceConfig) schema.Schema {
 			Computed:            true,
 			Default:             booldefault.StaticBool(false),
 		},
-		"labels": schema.StringAttribute{
-			MarkdownDescription: fmt.Sprintf("Label selector to filter %s (e.g., 'app=nginx,tier=frontend')", config.TypeName),
-			Optional:            true,
-		},
-		"field_selector": schema.StringAttribute{
-			MarkdownDescription: fmt.Sprintf("Field selector to filter %s (e.g., 'spec.nodeName=node1')", config.TypeName),
-			Optional:            true,
-		},
 
 		// Authentication config
 		"kube_config_type": schema.StringAttribute{
@@ -176,6 +225,30 @@ func GetCommonSchema(config ResourceConfig) schema.Schema {
 			Computed:            true,
 		},
 	}
+}
+
+// GetCommonSchema returns the common schema attributes for wait resources
+func GetCommonSchema(config ResourceConfig) schema.Schema {
+	attributes := GetBaseAttributes()
+
+	attributes["for"] = schema.StringAttribute{
+		MarkdownDescription: config.ForDescription,
+		Required:            true,
+	}
+	attributes["all"] = schema.BoolAttribute{
+		MarkdownDescription: fmt.Sprintf("Wait for all matching %s (true) or just one (false). Defaults to false.", config.TypeName),
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	attributes["labels"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Label selector to filter %s (e.g., 'app=nginx,tier=frontend')", config.TypeName),
+		Optional:            true,
+	}
+	attributes["field_selector"] = schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Field selector to filter %s (e.g., 'spec.nodeName=node1')", config.TypeName),
+		Optional:            true,
+	}
 
 	// Add namespace and name fields for namespaced resources
 	if config.IncludeNamespace {
@@ -198,6 +271,35 @@ func GetCommonSchema(config ResourceConfig) schema.Schema {
 		}
 	}
 
+	// Add assigned address outputs for resources like services and ingresses
+	if config.IncludeAddresses {
+		attributes["ips"] = schema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("IP addresses assigned to the matching %s", config.TypeName),
+			ElementType:         types.StringType,
+			Computed:            true,
+		}
+		attributes["hostnames"] = schema.ListAttribute{
+			MarkdownDescription: fmt.Sprintf("Hostnames assigned to the matching %s", config.TypeName),
+			ElementType:         types.StringType,
+			Computed:            true,
+		}
+	}
+
+	// Add image verification for pods and the workloads running them
+	if config.IncludeImages {
+		attributes["images"] = schema.MapAttribute{
+			MarkdownDescription: fmt.Sprintf("Expected image reference by container name. Every pod of the matching %s must run these images, otherwise the pods on the wrong image are reported. Cannot be combined with `current`, `healthy`, `jsonpath` or `delete` conditions.", config.TypeName),
+			ElementType:         types.StringType,
+			Optional:            true,
+		}
+		attributes["compare_image_digests"] = schema.BoolAttribute{
+			MarkdownDescription: "Also compare the `imageID` digests of the running containers: against the digest of the expected image when it is pinned, otherwise across all pods. Defaults to false.",
+			Optional:            true,
+			Computed:            true,
+			Default:             booldefault.StaticBool(false),
+		}
+	}
+
 	// Always include resource field - it's auto-populated for specific resources
 	attributes["resource"] = schema.StringAttribute{
 		MarkdownDescription: "The Kubernetes resource type to wait for (e.g., 'nodes', 'pods', 'deployments'). Auto-populated for specific resources.",
@@ -217,7 +319,7 @@ func GetGenericWaitSchema() schema.Schema {
 	baseConfig := ResourceConfig{
 		TypeName:         "resources",
 		Description:      "Waits for Kubernetes resources to meet specified conditions before allowing dependent resources to proceed.",
-		ForDescription:   "Condition to wait for (e.g., 'condition=Ready', 'condition=Available')",
+		ForDescription:   "Condition to wait for (e.g., 'condition=Ready', 'condition=Available', 'healthy', 'current')",
 		IncludeNamespace: true,
 	}
 
@@ -225,7 +327,7 @@ func GetGenericWaitSchema() schema.Schema {
 
 	// Override the resource field to be required for generic wait
 	baseSchema.Attributes["resource"] = schema.StringAttribute{
-		MarkdownDescription: "The Kubernetes resource type to wait for (e.g., 'nodes', 'pods', 'deployments').",
+		MarkdownDescription: "The Kubernetes resource type to wait for (e.g., 'nodes', 'pods', 'deployments', 'certificates.cert-manager.io').",
 		Required:            true,
 	}
 
@@ -233,131 +335,34 @@ func GetGenericWaitSchema() schema.Schema {
 }
 
 // Create performs the create operation for wait resources
-func (r *BaseWaitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, data interface{}) {
+func (r *BaseWaitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse, data waitResourceModel) {
 	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Extract common fields based on the data type
-	var (
-		forValue, nameValue, labelsValue, fieldSelectorValue string
-		namespaceValue                                       string
-		allValue                                             bool
-		timeoutValue, checkIntervalValue                     int64
-		kubeConfigTypeValue, kubeConfigValue, contextValue   string
-	)
-
-	// Handle both GenericWaitResourceModel and ClusterScopedWaitResourceModel
-	switch d := data.(type) {
-	case *GenericWaitResourceModel:
-		forValue = d.For.ValueString()
-		nameValue = d.Name.ValueString()
-		namespaceValue = d.Namespace.ValueString()
-		allValue = d.All.ValueBool()
-		timeoutValue = d.Timeout.ValueInt64()
-		checkIntervalValue = d.CheckInterval.ValueInt64()
-		labelsValue = d.Labels.ValueString()
-		fieldSelectorValue = d.FieldSelector.ValueString()
-		kubeConfigTypeValue = d.KubeConfigType.ValueString()
-		kubeConfigValue = d.KubeConfig.ValueString()
-		contextValue = d.Context.ValueString()
-
-		// Auto-populate the resource field if we have a resourceType
-		if r.resourceType != "" {
-			d.Resource = types.StringValue(r.resourceType)
-		}
-
-		// Check if resource field is still not set
-		if d.Resource.IsNull() || d.Resource.ValueString() == "" {
-			resp.Diagnostics.AddError(
-				"Missing resource type",
-				"The 'resource' field is required.",
-			)
-			return
-		}
-
-		// Set the internal resource type if not already set
-		if r.resourceType == "" {
-			r.resourceType = d.Resource.ValueString()
-		}
-
-	case *ClusterScopedWaitResourceModel:
-		forValue = d.For.ValueString()
-		nameValue = d.Name.ValueString()
-		namespaceValue = "" // Cluster-scoped resources don't have namespaces
-		allValue = d.All.ValueBool()
-		timeoutValue = d.Timeout.ValueInt64()
-		checkIntervalValue = d.CheckInterval.ValueInt64()
-		labelsValue = d.Labels.ValueString()
-		fieldSelectorValue = d.FieldSelector.ValueString()
-		kubeConfigTypeValue = d.KubeConfigType.ValueString()
-		kubeConfigValue = d.KubeConfig.ValueString()
-		contextValue = d.Context.ValueString()
-
-		// Auto-populate the resource field if we have a resourceType
-		if r.resourceType != "" {
-			d.Resource = types.StringValue(r.resourceType)
-		}
+	d := data.base()
 
-		// Check if resource field is still not set
-		if d.Resource.IsNull() || d.Resource.ValueString() == "" {
-			resp.Diagnostics.AddError(
-				"Missing resource type",
-				"The 'resource' field is required.",
-			)
-			return
-		}
+	// Auto-populate the resource field if we have a resourceType
+	if r.resourceType != "" {
+		d.Resource = types.StringValue(r.resourceType)
+	}
 
-		// Set the internal resource type if not already set
-		if r.resourceType == "" {
-			r.resourceType = d.Resource.ValueString()
-		}
-	default:
+	// Check if resource field is still not set
+	if d.Resource.IsNull() || d.Resource.ValueString() == "" {
 		resp.Diagnostics.AddError(
-			"Invalid data type",
-			"Unsupported resource model type",
+			"Missing resource type",
+			"The 'resource' field is required.",
 		)
 		return
 	}
 
-	// Create Kubernetes client config
-	kubeClientConfig := &kubernetes.ClientConfig{
-		KubeConfig:     kubeConfigValue,
-		KubeConfigPath: "",
-		Context:        contextValue,
+	// Set the internal resource type if not already set
+	if r.resourceType == "" {
+		r.resourceType = d.Resource.ValueString()
 	}
 
-	if kubeConfigTypeValue == "" {
-		kubeConfigTypeValue = "provider"
-	}
-
-	switch kubeConfigTypeValue {
-	case "raw":
-		kubeClientConfig.KubeConfig = kubeConfigValue
-		kubeClientConfig.KubeConfigPath = ""
-	case "file":
-		kubeClientConfig.KubeConfig = ""
-		kubeClientConfig.KubeConfigPath = kubeConfigValue
-	case "auto":
-		kubeClientConfig.KubeConfig = ""
-		kubeClientConfig.KubeConfigPath = ""
-	default: // "provider"
-		if r.providerConfig != nil {
-			switch r.providerConfig.KubeConfigType {
-			case "raw":
-				kubeClientConfig.KubeConfig = r.providerConfig.KubeConfig
-			case "file":
-				kubeClientConfig.KubeConfigPath = r.providerConfig.KubeConfig
-			default:
-				kubeClientConfig.KubeConfig = ""
-				kubeClientConfig.KubeConfigPath = ""
-			}
-			kubeClientConfig.Context = r.providerConfig.Context
-		}
-	}
-
-	client, err := kubernetes.NewClient(ctx, kubeClientConfig)
+	client, err := r.newClient(ctx, d.KubeConfigType.ValueString(), d.KubeConfig.ValueString(), d.Context.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Kubernetes client",
@@ -366,23 +371,27 @@ func (r *BaseWaitResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Get the correct namespace for the operation
-	namespace := r.getNamespaceValue(namespaceValue)
+	config := &kubernetes.WaitConfig{
+		Resource:      r.resourceType,
+		Name:          d.Name.ValueString(),
+		Namespace:     r.getNamespaceValue(data.namespace()),
+		Labels:        d.Labels.ValueString(),
+		FieldSelector: d.FieldSelector.ValueString(),
+		Condition:     d.For.ValueString(),
+		All:           d.All.ValueBool(),
+		Timeout:       time.Duration(d.Timeout.ValueInt64()) * time.Second,
+		CheckInterval: time.Duration(d.CheckInterval.ValueInt64()) * time.Second,
+	}
+
+	resp.Diagnostics.Append(data.applyOptions(ctx, config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Perform the wait operation
 	conditionChecker := &kubernetes.ConditionChecker{
 		Client: client,
-		Config: &kubernetes.WaitConfig{
-			Resource:      r.resourceType,
-			Name:          nameValue,
-			Namespace:     namespace,
-			Labels:        labelsValue,
-			FieldSelector: fieldSelectorValue,
-			Condition:     forValue,
-			All:           allValue,
-			Timeout:       time.Duration(timeoutValue) * time.Second,
-			CheckInterval: time.Duration(checkIntervalValue) * time.Second,
-		},
+		Config: config,
 	}
 
 	result, err := conditionChecker.WaitForCondition(ctx)
@@ -394,63 +403,46 @@ func (r *BaseWaitResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Set computed values based on data type
-	switch d := data.(type) {
-	case *GenericWaitResourceModel:
-		d.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
-		d.ConditionMet = types.BoolValue(result.ConditionMet)
-		d.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
-		d.Message = types.StringValue(result.Message)
-		if d.KubeConfigType.ValueString() == "" {
-			d.KubeConfigType = types.StringValue("provider")
-		}
-	case *ClusterScopedWaitResourceModel:
-		d.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
-		d.ConditionMet = types.BoolValue(result.ConditionMet)
-		d.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
-		d.Message = types.StringValue(result.Message)
-		if d.KubeConfigType.ValueString() == "" {
-			d.KubeConfigType = types.StringValue("provider")
-		}
+	// Set computed values
+	d.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	d.ConditionMet = types.BoolValue(result.ConditionMet)
+	d.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	d.Message = types.StringValue(result.Message)
+	if d.KubeConfigType.ValueString() == "" {
+		d.KubeConfigType = types.StringValue("provider")
+	}
+
+	if address, ok := data.(*AddressWaitResourceModel); ok {
+		ips, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.IPs))
+		resp.Diagnostics.Append(diags...)
+		address.IPs = ips
+		hostnames, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(result.Hostnames))
+		resp.Diagnostics.Append(diags...)
+		address.Hostnames = hostnames
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
 }
 
 // Read performs the read operation for wait resources
-func (r *BaseWaitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, data interface{}) {
+func (r *BaseWaitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse, data waitResourceModel) {
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	// Handle resource field - set internal resource type
-	switch d := data.(type) {
-	case *GenericWaitResourceModel:
-		if r.resourceType != "" {
-			d.Resource = types.StringValue(r.resourceType)
-		} else {
-			r.resourceType = d.Resource.ValueString()
-		}
-
-		// If check_once is enabled and condition was already met, skip re-checking
-		if d.CheckOnce.ValueBool() && d.ConditionMet.ValueBool() {
-			resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
-			return
-		}
-
-	case *ClusterScopedWaitResourceModel:
-		if r.resourceType != "" {
-			d.Resource = types.StringValue(r.resourceType)
-		} else {
-			r.resourceType = d.Resource.ValueString()
-		}
+	d := data.base()
+	if r.resourceType != "" {
+		d.Resource = types.StringValue(r.resourceType)
+	} else {
+		r.resourceType = d.Resource.ValueString()
+	}
 
-		// If check_once is enabled and condition was already met, skip re-checking
-		if d.CheckOnce.ValueBool() && d.ConditionMet.ValueBool() {
-			resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
-			return
-		}
+	// If check_once is enabled and condition was already met, skip re-checking
+	if d.CheckOnce.ValueBool() && d.ConditionMet.ValueBool() {
+		resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+		return
 	}
 
 	// Re-check the condition with a short timeout
@@ -472,12 +464,103 @@ func (r *BaseWaitResource) Delete(ctx context.Context, req resource.DeleteReques
 	// Nothing to do on delete for wait resources
 }
 
+// WaitForDelete waits on destroy until the resources selected by the state are deleted.
+// Resources call it from Delete when they are configured to wait on destroy.
+func (r *BaseWaitResource) WaitForDelete(ctx context.Context, resp *resource.DeleteResponse, data waitResourceModel) {
+	d := data.base()
+	if r.resourceType == "" {
+		r.resourceType = d.Resource.ValueString()
+	}
+
+	client, err := r.newClient(ctx, d.KubeConfigType.ValueString(), d.KubeConfig.ValueString(), d.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	conditionChecker := &kubernetes.ConditionChecker{
+		Client: client,
+		Config: &kubernetes.WaitConfig{
+			Resource:      r.resourceType,
+			Name:          d.Name.ValueString(),
+			Namespace:     r.getNamespaceValue(data.namespace()),
+			Labels:        d.Labels.ValueString(),
+			FieldSelector: d.FieldSelector.ValueString(),
+			Condition:     "delete",
+			All:           true,
+			Timeout:       time.Duration(d.Timeout.ValueInt64()) * time.Second,
+			CheckInterval: time.Duration(d.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	if _, err := conditionChecker.WaitForCondition(ctx); err != nil {
+		resp.Diagnostics.AddError(
+			"Wait for deletion failed",
+			err.Error(),
+		)
+	}
+}
+
+// newClient creates a Kubernetes client from the resource's authentication
+// settings, inheriting from the provider configuration when the type is "provider"
+func (r *BaseWaitResource) newClient(ctx context.Context, kubeConfigTypeValue, kubeConfigValue, contextValue string) (*kubernetes.Client, error) {
+	kubeClientConfig := &kubernetes.ClientConfig{
+		KubeConfig:     kubeConfigValue,
+		KubeConfigPath: "",
+		Context:        contextValue,
+	}
+
+	if kubeConfigTypeValue == "" {
+		kubeConfigTypeValue = "provider"
+	}
+
+	switch kubeConfigTypeValue {
+	case "raw":
+		kubeClientConfig.KubeConfig = kubeConfigValue
+		kubeClientConfig.KubeConfigPath = ""
+	case "file":
+		kubeClientConfig.KubeConfig = ""
+		kubeClientConfig.KubeConfigPath = kubeConfigValue
+	case "auto":
+		kubeClientConfig.KubeConfig = ""
+		kubeClientConfig.KubeConfigPath = ""
+	default: // "provider"
+		if r.providerConfig != nil {
+			switch r.providerConfig.KubeConfigType {
+			case "raw":
+				kubeClientConfig.KubeConfig = r.providerConfig.KubeConfig
+			case "file":
+				kubeClientConfig.KubeConfigPath = r.providerConfig.KubeConfig
+			default:
+				kubeClientConfig.KubeConfig = ""
+				kubeClientConfig.KubeConfigPath = ""
+			}
+			kubeClientConfig.Context = r.providerConfig.Context
+		}
+	}
+
+	return kubernetes.NewClient(ctx, kubeClientConfig)
+}
+
+// nonNilStrings returns an empty slice for nil so computed lists are known and empty
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
+
 // getNamespaceValue returns the namespace to use, with proper fallback logic
 // For cluster-scoped resources, this will return an empty string
 func (r *BaseWaitResource) getNamespaceValue(namespaceValue string) string {
 	// Cluster-scoped resources (like nodes) don't have namespaces
 	if r.resourceType == "nodes" || r.resourceType == "node" ||
 		r.resourceType == "persistentvolumes" || r.resourceType == "persistentvolume" ||
+		r.resourceType == "pvs" || r.resourceType == "pv" ||
+		r.resourceType == "namespaces" || r.resourceType == "namespace" || r.resourceType == "ns" ||
 		r.resourceType == "clusterroles" || r.resourceType == "clusterrole" ||
 		r.resourceType == "clusterrolebindings" || r.resourceType == "clusterrolebinding" {
 		return ""
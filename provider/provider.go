@@ -102,6 +102,22 @@ func (p *KubeWaitProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewIngressResource,
 		NewJobsResource,
 		NewCronJobsResource,
+		NewPVCsResource,
+		NewPVsResource,
+		NewNamespacesResource,
+		NewGatewayAPIResource,
+		NewClusterResource,
+		NewAPIResourceResource,
+		NewWebhookResource,
+		NewAccessResource,
+		NewHelmReleaseResource,
+		NewSecretsResource,
+		NewConfigMapsResource,
+		NewEventsResource,
+		NewPodLogsResource,
+		NewExecResource,
+		NewHTTPResource,
+		NewPortResource,
 	}
 }
 
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccessResource{}
+
+func NewAccessResource() resource.Resource {
+	return &AccessResource{}
+}
+
+// AccessResource defines the resource implementation.
+type AccessResource struct {
+	BaseWaitResource
+}
+
+// AccessResourceModel describes the resource data model.
+type AccessResourceModel struct {
+	// Access review subject and permissions
+	Permissions    types.List   `tfsdk:"permissions"`
+	User           types.String `tfsdk:"user"`
+	Groups         types.List   `tfsdk:"groups"`
+	ServiceAccount types.String `tfsdk:"service_account"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+}
+
+// AccessPermissionModel describes a single permission to verify.
+type AccessPermissionModel struct {
+	Verb        types.String `tfsdk:"verb"`
+	Group       types.String `tfsdk:"group"`
+	Resource    types.String `tfsdk:"resource"`
+	Subresource types.String `tfsdk:"subresource"`
+	Name        types.String `tfsdk:"name"`
+	Namespace   types.String `tfsdk:"namespace"`
+}
+
+func (r *AccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access"
+	r.resourceType = "access"
+}
+
+func (r *AccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["permissions"] = schema.ListNestedAttribute{
+		MarkdownDescription: "Permissions that must all be allowed. At least one is required.",
+		Required:            true,
+		Validators: []validator.List{
+			listvalidator.SizeAtLeast(1),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"verb": schema.StringAttribute{
+					MarkdownDescription: "Verb to check (e.g., 'get', 'list', 'create', '*').",
+					Required:            true,
+				},
+				"resource": schema.StringAttribute{
+					MarkdownDescription: "Resource to check (e.g., 'deployments', 'secrets').",
+					Required:            true,
+				},
+				"group": schema.StringAttribute{
+					MarkdownDescription: "API group of the resource (e.g., 'apps'). Empty for the core group.",
+					Optional:            true,
+				},
+				"subresource": schema.StringAttribute{
+					MarkdownDescription: "Subresource to check (e.g., 'status', 'exec').",
+					Optional:            true,
+				},
+				"name": schema.StringAttribute{
+					MarkdownDescription: "Name of a specific resource to check.",
+					Optional:            true,
+				},
+				"namespace": schema.StringAttribute{
+					MarkdownDescription: "Namespace to check. Empty for cluster-wide access.",
+					Optional:            true,
+				},
+			},
+		},
+	}
+	attributes["user"] = schema.StringAttribute{
+		MarkdownDescription: "User to check with a SubjectAccessReview. Defaults to the identity of the provider (SelfSubjectAccessReview).",
+		Optional:            true,
+	}
+	attributes["groups"] = schema.ListAttribute{
+		MarkdownDescription: "Groups of the user to check with a SubjectAccessReview.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+	attributes["service_account"] = schema.StringAttribute{
+		MarkdownDescription: "Service account to check with a SubjectAccessReview, in format 'namespace/name'. Cannot be combined with `user` or `groups`.",
+		Optional:            true,
+		Validators: []validator.String{
+			stringvalidator.ConflictsWith(path.MatchRoot("user"), path.MatchRoot("groups")),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for RBAC permissions to be granted, using SelfSubjectAccessReview or SubjectAccessReview, before allowing dependent resources to proceed.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *AccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	permissions := []AccessPermissionModel{}
+	resp.Diagnostics.Append(data.Permissions.ElementsAs(ctx, &permissions, false)...)
+	groups := []string{}
+	if !data.Groups.IsNull() && !data.Groups.IsUnknown() {
+		resp.Diagnostics.Append(data.Groups.ElementsAs(ctx, &groups, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checks := make([]kubernetes.AccessCheck, 0, len(permissions))
+	for _, permission := range permissions {
+		checks = append(checks, kubernetes.AccessCheck{
+			Verb:        permission.Verb.ValueString(),
+			Group:       permission.Group.ValueString(),
+			Resource:    permission.Resource.ValueString(),
+			Subresource: permission.Subresource.ValueString(),
+			Name:        permission.Name.ValueString(),
+			Namespace:   permission.Namespace.ValueString(),
+		})
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	accessChecker := &kubernetes.AccessChecker{
+		Client: client,
+		Config: &kubernetes.AccessConfig{
+			Checks:         checks,
+			User:           data.User.ValueString(),
+			Groups:         groups,
+			ServiceAccount: data.ServiceAccount.ValueString(),
+			Timeout:        time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval:  time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	result, err := accessChecker.WaitForAccess(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *AccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
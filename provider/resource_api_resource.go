@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &APIResourceResource{}
+
+func NewAPIResourceResource() resource.Resource {
+	return &APIResourceResource{}
+}
+
+// APIResourceResource defines the resource implementation.
+type APIResourceResource struct {
+	BaseWaitResource
+}
+
+// APIResourceResourceModel describes the resource data model.
+type APIResourceResourceModel struct {
+	// API resource selection
+	APIVersion types.String `tfsdk:"api_version"`
+	Kind       types.String `tfsdk:"kind"`
+	Resource   types.String `tfsdk:"resource"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+	Namespaced   types.Bool   `tfsdk:"namespaced"`
+}
+
+func (r *APIResourceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_resource"
+	r.resourceType = "apiresources"
+}
+
+func (r *APIResourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["api_version"] = schema.StringAttribute{
+		MarkdownDescription: "API group and version to wait for (e.g., 'cert-manager.io/v1', 'metrics.k8s.io/v1beta1').",
+		Required:            true,
+	}
+	attributes["kind"] = schema.StringAttribute{
+		MarkdownDescription: "Kind that must be served in the group version (e.g., 'Certificate').",
+		Optional:            true,
+	}
+	attributes["resource"] = schema.StringAttribute{
+		MarkdownDescription: "Plural resource name that must be served in the group version (e.g., 'certificates'). Auto-populated from discovery when `kind` is set.",
+		Optional:            true,
+		Computed:            true,
+	}
+	attributes["namespaced"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether the served resource is namespaced",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for an API group version, kind or resource to be served by the API server (CRDs Established and NamesAccepted, APIServices Available) before allowing dependent resources to proceed.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *APIResourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APIResourceResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	apiResourceChecker := &kubernetes.APIResourceChecker{
+		Client: client,
+		Config: &kubernetes.APIResourceConfig{
+			APIVersion:    data.APIVersion.ValueString(),
+			Kind:          data.Kind.ValueString(),
+			Resource:      data.Resource.ValueString(),
+			Timeout:       time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval: time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	result, err := apiResourceChecker.WaitForAPIResource(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.Resource = types.StringValue(result.Resource)
+	data.Namespaced = types.BoolValue(result.Namespaced)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIResourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APIResourceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APIResourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *APIResourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ClusterResource{}
+
+func NewClusterResource() resource.Resource {
+	return &ClusterResource{}
+}
+
+// ClusterResource defines the resource implementation.
+type ClusterResource struct {
+	BaseWaitResource
+}
+
+// ClusterResourceModel describes the resource data model.
+type ClusterResourceModel struct {
+	// Health checks
+	Readyz     types.Bool   `tfsdk:"readyz"`
+	Livez      types.Bool   `tfsdk:"livez"`
+	Verbose    types.Bool   `tfsdk:"verbose"`
+	Checks     types.List   `tfsdk:"checks"`
+	MinVersion types.String `tfsdk:"min_version"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID            types.String `tfsdk:"id"`
+	ConditionMet  types.Bool   `tfsdk:"condition_met"`
+	LastChecked   types.String `tfsdk:"last_checked"`
+	Message       types.String `tfsdk:"message"`
+	ServerVersion types.String `tfsdk:"server_version"`
+	Platform      types.String `tfsdk:"platform"`
+}
+
+func (r *ClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+	r.resourceType = "cluster"
+}
+
+func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["readyz"] = schema.BoolAttribute{
+		MarkdownDescription: "Wait for the API server `/readyz` endpoint to report ready. Defaults to true.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+	}
+	attributes["livez"] = schema.BoolAttribute{
+		MarkdownDescription: "Wait for the API server `/livez` endpoint to report live. Defaults to true.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(true),
+	}
+	attributes["verbose"] = schema.BoolAttribute{
+		MarkdownDescription: "Query the health endpoints in verbose mode and report the individual failing checks. Defaults to false.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+	attributes["checks"] = schema.ListAttribute{
+		MarkdownDescription: "Individual `/readyz` checks that must pass (e.g., `[\"etcd\", \"informer-sync\"]`). When set, only these checks are required.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+	attributes["min_version"] = schema.StringAttribute{
+		MarkdownDescription: "Minimum Kubernetes server version required (e.g., '1.28' or 'v1.28.3').",
+		Optional:            true,
+	}
+	attributes["server_version"] = schema.StringAttribute{
+		MarkdownDescription: "Kubernetes server version reported by discovery",
+		Computed:            true,
+	}
+	attributes["platform"] = schema.StringAttribute{
+		MarkdownDescription: "Platform reported by discovery (e.g., 'linux/amd64')",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for the Kubernetes API server health endpoints to report healthy before allowing dependent resources to proceed.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ClusterResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checks := []string{}
+	if !data.Checks.IsNull() && !data.Checks.IsUnknown() {
+		resp.Diagnostics.Append(data.Checks.ElementsAs(ctx, &checks, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	healthChecker := &kubernetes.ClusterHealthChecker{
+		Client: client,
+		Config: &kubernetes.ClusterHealthConfig{
+			Readyz:        data.Readyz.ValueBool(),
+			Livez:         data.Livez.ValueBool(),
+			Verbose:       data.Verbose.ValueBool(),
+			Checks:        checks,
+			MinVersion:    data.MinVersion.ValueString(),
+			Timeout:       time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval: time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	result, err := healthChecker.WaitForHealthy(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.ServerVersion = types.StringValue(result.ServerVersion)
+	data.Platform = types.StringValue(result.Platform)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ClusterResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConfigMapsResource{}
+
+func NewConfigMapsResource() resource.Resource {
+	return &ConfigMapsResource{DataWaitResource{kind: "configmap"}}
+}
+
+// ConfigMapsResource defines the resource implementation.
+type ConfigMapsResource struct {
+	DataWaitResource
+}
+
+// ConfigMapsResourceModel describes the resource data model.
+type ConfigMapsResourceModel = DataWaitResourceModel
+
+func (r *ConfigMapsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_configmaps"
+	r.resourceType = "configmaps"
+}
+
+func (r *ConfigMapsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = GetDataSchema("ConfigMap", "Waits for a ConfigMap written by a controller to exist with the required keys, and exposes selected values.")
+}
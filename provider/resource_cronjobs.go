@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -19,7 +21,7 @@ type CronJobsResource struct {
 }
 
 // CronJobsResourceModel describes the resource data model.
-type CronJobsResourceModel = GenericWaitResourceModel
+type CronJobsResourceModel = CronJobWaitResourceModel
 
 func (r *CronJobsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_cronjobs"
@@ -30,9 +32,16 @@ func (r *CronJobsResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = GetCommonSchema(ResourceConfig{
 		TypeName:         "cronjobs",
 		Description:      "Waits for Kubernetes cronjobs to meet specified conditions before allowing dependent resources to proceed.",
-		ForDescription:   "Condition to wait for (e.g., 'condition=Complete', 'condition=Failed')",
+		ForDescription:   "Condition to wait for (e.g., 'job=completed', 'lastsuccess=2024-01-01T00:00:00Z', 'suspended=false', 'exists=true')",
 		IncludeNamespace: true,
 	})
+
+	resp.Schema.Attributes["trigger"] = schema.BoolAttribute{
+		MarkdownDescription: "Create a Job from the template of each matching cronjob when the wait starts, like `kubectl create job --from=cronjob/<name>`. Requires `for = \"job=completed\"`, which waits for the triggered run. Defaults to false.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
 }
 
 func (r *CronJobsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -19,7 +19,7 @@ type DaemonSetsResource struct {
 }
 
 // DaemonSetsResourceModel describes the resource data model.
-type DaemonSetsResourceModel = GenericWaitResourceModel
+type DaemonSetsResourceModel = WorkloadWaitResourceModel
 
 func (r *DaemonSetsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_daemonsets"
@@ -32,6 +32,7 @@ func (r *DaemonSetsResource) Schema(ctx context.Context, req resource.SchemaRequ
 		Description:      "Waits for Kubernetes daemonsets to meet specified conditions before allowing dependent resources to proceed.",
 		ForDescription:   "Condition to wait for (e.g., 'condition=Available', 'jsonpath={.status.numberReady}')",
 		IncludeNamespace: true,
+		IncludeImages:    true,
 	})
 }
 
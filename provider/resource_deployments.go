@@ -19,7 +19,7 @@ type DeploymentsResource struct {
 }
 
 // DeploymentsResourceModel describes the resource data model.
-type DeploymentsResourceModel = GenericWaitResourceModel
+type DeploymentsResourceModel = WorkloadWaitResourceModel
 
 func (r *DeploymentsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_deployments"
@@ -32,6 +32,7 @@ func (r *DeploymentsResource) Schema(ctx context.Context, req resource.SchemaReq
 		Description:      "Waits for Kubernetes deployments to meet specified conditions before allowing dependent resources to proceed.",
 		ForDescription:   "Condition to wait for (e.g., 'condition=Available', 'condition=Progressing')",
 		IncludeNamespace: true,
+		IncludeImages:    true,
 	})
 }
 
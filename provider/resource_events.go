@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EventsResource{}
+
+func NewEventsResource() resource.Resource {
+	return &EventsResource{}
+}
+
+// EventsResource defines the resource implementation.
+type EventsResource struct {
+	BaseWaitResource
+}
+
+// EventsResourceModel describes the resource data model.
+type EventsResourceModel struct {
+	// Event matching
+	Namespace    types.String `tfsdk:"namespace"`
+	Kind         types.String `tfsdk:"kind"`
+	Name         types.String `tfsdk:"name"`
+	Reason       types.String `tfsdk:"reason"`
+	Type         types.String `tfsdk:"type"`
+	MessageRegex types.String `tfsdk:"message_regex"`
+	FailOn       types.Object `tfsdk:"fail_on"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+	EventMessage types.String `tfsdk:"event_message"`
+}
+
+// EventsFailOnModel describes the Warning events that fail the wait.
+type EventsFailOnModel struct {
+	Reason       types.String `tfsdk:"reason"`
+	MessageRegex types.String `tfsdk:"message_regex"`
+}
+
+func (r *EventsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_events"
+	r.resourceType = "events"
+}
+
+func (r *EventsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["namespace"] = schema.StringAttribute{
+		MarkdownDescription: "Namespace of the events. Defaults to 'default'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("default"),
+	}
+	attributes["kind"] = schema.StringAttribute{
+		MarkdownDescription: "Kind of the involved object (e.g., 'Deployment', 'HelmRelease').",
+		Optional:            true,
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the involved object.",
+		Optional:            true,
+	}
+	attributes["reason"] = schema.StringAttribute{
+		MarkdownDescription: "Reason of the event to wait for (e.g., 'Synced', 'ScalingReplicaSet').",
+		Optional:            true,
+	}
+	attributes["type"] = schema.StringAttribute{
+		MarkdownDescription: "Type of the event to wait for: 'Normal' or 'Warning'.",
+		Optional:            true,
+	}
+	attributes["message_regex"] = schema.StringAttribute{
+		MarkdownDescription: "Regular expression the message of the event to wait for must match.",
+		Optional:            true,
+	}
+	attributes["fail_on"] = schema.SingleNestedAttribute{
+		MarkdownDescription: "Fail the wait as soon as a matching Warning event for the involved object is seen. Without `reason`, `type` or `message_regex` the resource only guards against these events, and succeeds when none is seen before the timeout.",
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Reason of the failing Warning events (e.g., 'SyncFailed'). Any reason when not set.",
+				Optional:            true,
+			},
+			"message_regex": schema.StringAttribute{
+				MarkdownDescription: "Regular expression the message of the failing Warning events must match.",
+				Optional:            true,
+			},
+		},
+	}
+	attributes["event_message"] = schema.StringAttribute{
+		MarkdownDescription: "Message of the matching event",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for a Kubernetes Event created after the wait started, matching the involved object, reason, type and message, or fails on Warning events.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *EventsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EventsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	eventsConfig := &kubernetes.EventsConfig{
+		Namespace:     r.getNamespaceValue(data.Namespace.ValueString()),
+		Kind:          data.Kind.ValueString(),
+		Name:          data.Name.ValueString(),
+		Reason:        data.Reason.ValueString(),
+		Type:          data.Type.ValueString(),
+		Message:       data.MessageRegex.ValueString(),
+		Timeout:       time.Duration(data.Timeout.ValueInt64()) * time.Second,
+		CheckInterval: time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+	}
+
+	if !data.FailOn.IsNull() && !data.FailOn.IsUnknown() {
+		var failOn EventsFailOnModel
+		resp.Diagnostics.Append(data.FailOn.As(ctx, &failOn, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		eventsConfig.FailOn = true
+		eventsConfig.FailOnReason = failOn.Reason.ValueString()
+		eventsConfig.FailOnMessage = failOn.MessageRegex.ValueString()
+	} else if eventsConfig.Reason == "" && eventsConfig.Type == "" && eventsConfig.Message == "" {
+		resp.Diagnostics.AddError(
+			"Missing event criteria",
+			"At least one of reason, type, message_regex or fail_on must be set.",
+		)
+		return
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	eventsChecker := &kubernetes.EventsChecker{
+		Client: client,
+		Config: eventsConfig,
+	}
+
+	result, err := eventsChecker.WaitForEvents(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.EventMessage = types.StringValue(result.EventMessage)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EventsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EventsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EventsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *EventsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
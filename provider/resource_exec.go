@@ -0,0 +1,205 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ExecResource{}
+
+func NewExecResource() resource.Resource {
+	return &ExecResource{}
+}
+
+// ExecResource defines the resource implementation.
+type ExecResource struct {
+	BaseWaitResource
+}
+
+// ExecResourceModel describes the resource data model.
+type ExecResourceModel struct {
+	// Pod selection and command
+	Namespace      types.String `tfsdk:"namespace"`
+	Name           types.String `tfsdk:"name"`
+	Labels         types.String `tfsdk:"labels"`
+	Container      types.String `tfsdk:"container"`
+	Command        types.List   `tfsdk:"command"`
+	StdoutPattern  types.String `tfsdk:"stdout_pattern"`
+	CommandTimeout types.Int64  `tfsdk:"command_timeout"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+	Pod          types.String `tfsdk:"pod"`
+	Stdout       types.String `tfsdk:"stdout"`
+	Stderr       types.String `tfsdk:"stderr"`
+}
+
+func (r *ExecResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exec"
+	r.resourceType = "exec"
+}
+
+func (r *ExecResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["namespace"] = schema.StringAttribute{
+		MarkdownDescription: "Namespace of the pod. Defaults to 'default'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("default"),
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the pod to run the command in. Either `name` or `labels` must be set.",
+		Optional:            true,
+	}
+	attributes["labels"] = schema.StringAttribute{
+		MarkdownDescription: "Label selector of the pods to run the command in (e.g., 'app=postgres'). The command runs in the first running pod by name.",
+		Optional:            true,
+	}
+	attributes["container"] = schema.StringAttribute{
+		MarkdownDescription: "Container to run the command in. Defaults to the `kubectl.kubernetes.io/default-container` annotation or the first container.",
+		Optional:            true,
+	}
+	attributes["command"] = schema.ListAttribute{
+		MarkdownDescription: "Command and arguments to run, without a shell (e.g., `[\"pg_isready\", \"-U\", \"postgres\"]`).",
+		ElementType:         types.StringType,
+		Required:            true,
+	}
+	attributes["stdout_pattern"] = schema.StringAttribute{
+		MarkdownDescription: "Regular expression the stdout of the command must match in addition to exiting with 0 (e.g., 'PONG').",
+		Optional:            true,
+	}
+	attributes["command_timeout"] = schema.Int64Attribute{
+		MarkdownDescription: "Maximum time in seconds a single run of the command may take. A run that takes longer is stopped and the command runs again at the next interval. Defaults to `check_interval`.",
+		Optional:            true,
+	}
+	attributes["pod"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the pod the command succeeded in",
+		Computed:            true,
+	}
+	attributes["stdout"] = schema.StringAttribute{
+		MarkdownDescription: "Stdout of the successful command",
+		Computed:            true,
+	}
+	attributes["stderr"] = schema.StringAttribute{
+		MarkdownDescription: "Stderr of the successful command",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Runs a command in a running container through the pods/exec subresource every interval until it exits with 0 and, optionally, its stdout matches a pattern.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *ExecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExecResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (data.Name.ValueString() == "") == (data.Labels.ValueString() == "") {
+		resp.Diagnostics.AddError(
+			"Invalid pod selection",
+			"Exactly one of 'name' or 'labels' must be set.",
+		)
+		return
+	}
+
+	command := []string{}
+	resp.Diagnostics.Append(data.Command.ElementsAs(ctx, &command, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	execChecker := &kubernetes.ExecChecker{
+		Client: client,
+		Config: &kubernetes.ExecConfig{
+			Namespace:     r.getNamespaceValue(data.Namespace.ValueString()),
+			Name:          data.Name.ValueString(),
+			Labels:        data.Labels.ValueString(),
+			Container:     data.Container.ValueString(),
+			Command:       command,
+			StdoutPattern: data.StdoutPattern.ValueString(),
+			Timeout:       time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval: time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+	if !data.CommandTimeout.IsNull() && !data.CommandTimeout.IsUnknown() {
+		execChecker.Config.CommandTimeout = time.Duration(data.CommandTimeout.ValueInt64()) * time.Second
+	}
+
+	result, err := execChecker.WaitForExec(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.Pod = types.StringValue(result.Pod)
+	data.Stdout = types.StringValue(result.Stdout)
+	data.Stderr = types.StringValue(result.Stderr)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExecResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *ExecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GatewayAPIResource{}
+
+func NewGatewayAPIResource() resource.Resource {
+	return &GatewayAPIResource{}
+}
+
+// GatewayAPIResource defines the resource implementation.
+type GatewayAPIResource struct {
+	BaseWaitResource
+}
+
+// GatewayAPIResourceModel describes the resource data model.
+type GatewayAPIResourceModel = AddressWaitResourceModel
+
+func (r *GatewayAPIResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gateway_api"
+}
+
+func (r *GatewayAPIResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = GetCommonSchema(ResourceConfig{
+		TypeName:         "Gateway API resources",
+		Description:      "Waits for Gateway API gateways and routes to meet specified conditions before allowing dependent resources to proceed.",
+		ForDescription:   "Condition to wait for (e.g., 'condition=Programmed', 'condition=Accepted', 'listeners=programmed'). Route conditions must be met for every parent in `status.parents`.",
+		IncludeNamespace: true,
+		IncludeAddresses: true,
+	})
+
+	// The Gateway API kind is required
+	resp.Schema.Attributes["resource"] = schema.StringAttribute{
+		MarkdownDescription: "The Gateway API resource type to wait for: 'gateways', 'httproutes', 'grpcroutes', 'tlsroutes', 'tcproutes' or 'udproutes'.",
+		Required:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf("gateways", "httproutes", "grpcroutes", "tlsroutes", "tcproutes", "udproutes"),
+		},
+	}
+}
+
+func (r *GatewayAPIResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GatewayAPIResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the resource type from the plan
+	r.resourceType = data.Resource.ValueString()
+
+	// Use the base wait resource functionality
+	r.BaseWaitResource.Create(ctx, req, resp, &data)
+}
+
+func (r *GatewayAPIResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GatewayAPIResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set the resource type from the state
+	r.resourceType = data.Resource.ValueString()
+
+	// Use the base wait resource functionality
+	r.BaseWaitResource.Read(ctx, req, resp, &data)
+}
+
+func (r *GatewayAPIResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *GatewayAPIResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
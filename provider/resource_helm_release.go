@@ -0,0 +1,178 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HelmReleaseResource{}
+
+func NewHelmReleaseResource() resource.Resource {
+	return &HelmReleaseResource{}
+}
+
+// HelmReleaseResource defines the resource implementation.
+type HelmReleaseResource struct {
+	BaseWaitResource
+}
+
+// HelmReleaseResourceModel describes the resource data model.
+type HelmReleaseResourceModel struct {
+	// Release selection
+	Name        types.String `tfsdk:"name"`
+	Namespace   types.String `tfsdk:"namespace"`
+	Status      types.String `tfsdk:"status"`
+	MinRevision types.Int64  `tfsdk:"min_revision"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+	Revision     types.Int64  `tfsdk:"revision"`
+	Chart        types.String `tfsdk:"chart"`
+	ChartVersion types.String `tfsdk:"chart_version"`
+	AppVersion   types.String `tfsdk:"app_version"`
+}
+
+func (r *HelmReleaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_helm_release"
+	r.resourceType = "helmreleases"
+}
+
+func (r *HelmReleaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the Helm release",
+		Required:            true,
+	}
+	attributes["namespace"] = schema.StringAttribute{
+		MarkdownDescription: "Namespace the release is installed in. Defaults to 'default'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("default"),
+	}
+	attributes["status"] = schema.StringAttribute{
+		MarkdownDescription: "Status the latest revision of the release must reach (e.g., 'deployed'). Defaults to 'deployed'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("deployed"),
+	}
+	attributes["min_revision"] = schema.Int64Attribute{
+		MarkdownDescription: "Minimum revision of the release, e.g. to wait for an upgrade. Failed revisions below it are ignored while waiting.",
+		Optional:            true,
+	}
+	attributes["revision"] = schema.Int64Attribute{
+		MarkdownDescription: "Latest revision of the release",
+		Computed:            true,
+	}
+	attributes["chart"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the chart of the latest revision",
+		Computed:            true,
+	}
+	attributes["chart_version"] = schema.StringAttribute{
+		MarkdownDescription: "Version of the chart of the latest revision",
+		Computed:            true,
+	}
+	attributes["app_version"] = schema.StringAttribute{
+		MarkdownDescription: "App version of the chart of the latest revision",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for a Helm release, installed by any tool using Helm's default Secret storage, to reach a status at a minimum revision by reading its `sh.helm.release.v1` Secrets. Fails when the release is `failed` or `pending-rollback`.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *HelmReleaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HelmReleaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	helmReleaseChecker := &kubernetes.HelmReleaseChecker{
+		Client: client,
+		Config: &kubernetes.HelmReleaseConfig{
+			Name:          data.Name.ValueString(),
+			Namespace:     r.getNamespaceValue(data.Namespace.ValueString()),
+			Status:        data.Status.ValueString(),
+			MinRevision:   int(data.MinRevision.ValueInt64()),
+			Timeout:       time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval: time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	result, err := helmReleaseChecker.WaitForHelmRelease(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.Revision = types.Int64Value(int64(result.Revision))
+	data.Chart = types.StringValue(result.Chart)
+	data.ChartVersion = types.StringValue(result.ChartVersion)
+	data.AppVersion = types.StringValue(result.AppVersion)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HelmReleaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HelmReleaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HelmReleaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *HelmReleaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &HTTPResource{}
+
+func NewHTTPResource() resource.Resource {
+	return &HTTPResource{}
+}
+
+// HTTPResource defines the resource implementation.
+type HTTPResource struct {
+	BaseWaitResource
+}
+
+// HTTPResourceModel describes the resource data model.
+type HTTPResourceModel struct {
+	// Proxy target
+	Namespace types.String `tfsdk:"namespace"`
+	Service   types.String `tfsdk:"service"`
+	Pod       types.String `tfsdk:"pod"`
+	Port      types.String `tfsdk:"port"`
+	Scheme    types.String `tfsdk:"scheme"`
+
+	// Request
+	Method         types.String `tfsdk:"method"`
+	Path           types.String `tfsdk:"path"`
+	RequestHeaders types.Map    `tfsdk:"request_headers"`
+	RequestBody    types.String `tfsdk:"request_body"`
+
+	// Expected response
+	StatusCode      types.Int64  `tfsdk:"status_code"`
+	BodyRegex       types.String `tfsdk:"body_regex"`
+	JSONPath        types.String `tfsdk:"json_path"`
+	JSONValue       types.String `tfsdk:"json_value"`
+	ResponseHeaders types.Map    `tfsdk:"response_headers"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+	ResponseBody types.String `tfsdk:"response_body"`
+}
+
+func (r *HTTPResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http"
+	r.resourceType = "http"
+}
+
+func (r *HTTPResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["namespace"] = schema.StringAttribute{
+		MarkdownDescription: "Namespace of the service or pod. Defaults to 'default'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("default"),
+	}
+	attributes["service"] = schema.StringAttribute{
+		MarkdownDescription: "Service to send the request to through `services/proxy`. Either `service` or `pod` must be set.",
+		Optional:            true,
+	}
+	attributes["pod"] = schema.StringAttribute{
+		MarkdownDescription: "Pod to send the request to through `pods/proxy`.",
+		Optional:            true,
+	}
+	attributes["port"] = schema.StringAttribute{
+		MarkdownDescription: "Port name or number of the service or pod. Defaults to the first port of the service, or port 80 of the pod.",
+		Optional:            true,
+	}
+	attributes["scheme"] = schema.StringAttribute{
+		MarkdownDescription: "Scheme the API server uses to reach the target: 'http' or 'https'. Defaults to 'http'. Certificates of https targets are not verified by the API server.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("http"),
+	}
+	attributes["method"] = schema.StringAttribute{
+		MarkdownDescription: "HTTP method of the request. Defaults to 'GET'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("GET"),
+	}
+	attributes["path"] = schema.StringAttribute{
+		MarkdownDescription: "Path of the request, including the query (e.g., '/healthz', '/status?verbose=1'). Defaults to '/'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("/"),
+	}
+	attributes["request_headers"] = schema.MapAttribute{
+		MarkdownDescription: "Headers sent with the request.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+	attributes["request_body"] = schema.StringAttribute{
+		MarkdownDescription: "Body sent with the request.",
+		Optional:            true,
+	}
+	attributes["status_code"] = schema.Int64Attribute{
+		MarkdownDescription: "Expected status code of the response. Defaults to 200.",
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(200),
+	}
+	attributes["body_regex"] = schema.StringAttribute{
+		MarkdownDescription: "Regular expression the body of the response must match.",
+		Optional:            true,
+	}
+	attributes["json_path"] = schema.StringAttribute{
+		MarkdownDescription: "JSONPath expression evaluated against the JSON body of the response (e.g., '{.status}'). Without `json_value` the value must not be empty.",
+		Optional:            true,
+	}
+	attributes["json_value"] = schema.StringAttribute{
+		MarkdownDescription: "Expected value of `json_path` (e.g., 'UP').",
+		Optional:            true,
+	}
+	attributes["response_headers"] = schema.MapAttribute{
+		MarkdownDescription: "Regular expressions, by header name, that the response headers must match.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+	attributes["response_body"] = schema.StringAttribute{
+		MarkdownDescription: "Body of the successful response",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Waits for an HTTP endpoint of a service or pod to answer as expected, sending requests through the API server's `services/proxy` or `pods/proxy` subresource so the pods do not need to be reachable from where Terraform runs.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *HTTPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data HTTPResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (data.Service.ValueString() == "") == (data.Pod.ValueString() == "") {
+		resp.Diagnostics.AddError(
+			"Invalid proxy target",
+			"Exactly one of 'service' or 'pod' must be set.",
+		)
+		return
+	}
+
+	requestHeaders := map[string]string{}
+	if !data.RequestHeaders.IsNull() && !data.RequestHeaders.IsUnknown() {
+		resp.Diagnostics.Append(data.RequestHeaders.ElementsAs(ctx, &requestHeaders, false)...)
+	}
+	responseHeaders := map[string]string{}
+	if !data.ResponseHeaders.IsNull() && !data.ResponseHeaders.IsUnknown() {
+		resp.Diagnostics.Append(data.ResponseHeaders.ElementsAs(ctx, &responseHeaders, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	httpChecker := &kubernetes.HTTPChecker{
+		Client: client,
+		Config: &kubernetes.HTTPConfig{
+			Namespace:       r.getNamespaceValue(data.Namespace.ValueString()),
+			Service:         data.Service.ValueString(),
+			Pod:             data.Pod.ValueString(),
+			Port:            data.Port.ValueString(),
+			Scheme:          data.Scheme.ValueString(),
+			Method:          data.Method.ValueString(),
+			Path:            data.Path.ValueString(),
+			RequestHeaders:  requestHeaders,
+			RequestBody:     data.RequestBody.ValueString(),
+			StatusCode:      int(data.StatusCode.ValueInt64()),
+			BodyPattern:     data.BodyRegex.ValueString(),
+			JSONPath:        data.JSONPath.ValueString(),
+			JSONValue:       data.JSONValue.ValueString(),
+			ResponseHeaders: responseHeaders,
+			Timeout:         time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval:   time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	result, err := httpChecker.WaitForHTTP(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.ResponseBody = types.StringValue(result.Body)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HTTPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data HTTPResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *HTTPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *HTTPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
@@ -19,7 +19,7 @@ type IngressResource struct {
 }
 
 // IngressResourceModel describes the resource data model.
-type IngressResourceModel = GenericWaitResourceModel
+type IngressResourceModel = AddressWaitResourceModel
 
 func (r *IngressResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ingress"
@@ -30,8 +30,9 @@ func (r *IngressResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = GetCommonSchema(ResourceConfig{
 		TypeName:         "ingress",
 		Description:      "Waits for Kubernetes ingress to meet specified conditions before allowing dependent resources to proceed.",
-		ForDescription:   "Condition to wait for (e.g., 'jsonpath={.status.loadBalancer.ingress[0].ip}', 'condition=Ready')",
+		ForDescription:   "Condition to wait for (e.g., 'loadbalancer=ready', 'backends=ready', 'tls=ready', 'class=ready', 'ready=true')",
 		IncludeNamespace: true,
+		IncludeAddresses: true,
 	})
 }
 
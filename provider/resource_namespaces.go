@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NamespacesResource{}
+
+func NewNamespacesResource() resource.Resource {
+	return &NamespacesResource{}
+}
+
+// NamespacesResource defines the resource implementation.
+type NamespacesResource struct {
+	BaseWaitResource
+}
+
+// NamespacesResourceModel describes the resource data model.
+type NamespacesResourceModel struct {
+	ClusterScopedWaitResourceModel
+
+	// Namespace-specific options
+	WaitOnDestroy types.Bool `tfsdk:"wait_on_destroy"`
+}
+
+func (r *NamespacesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespaces"
+	r.resourceType = "namespaces"
+}
+
+func (r *NamespacesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = GetCommonSchema(ResourceConfig{
+		TypeName:         "namespaces",
+		Description:      "Waits for Kubernetes namespaces to meet specified conditions before allowing dependent resources to proceed.",
+		ForDescription:   "Condition to wait for (e.g., 'phase=Active', 'delete')",
+		IncludeNamespace: false, // Namespaces are cluster-scoped
+	})
+
+	resp.Schema.Attributes["wait_on_destroy"] = schema.BoolAttribute{
+		MarkdownDescription: "If true, destroying this resource waits until the matching namespaces are deleted, and fails with the conditions and finalizers blocking their termination on timeout. Defaults to false.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+	}
+}
+
+func (r *NamespacesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data NamespacesResourceModel
+	// Set the resource type before calling the base method
+	r.resourceType = "namespaces"
+	r.BaseWaitResource.Create(ctx, req, resp, &data)
+}
+
+func (r *NamespacesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data NamespacesResourceModel
+	// Set the resource type before calling the base method
+	r.resourceType = "namespaces"
+	r.BaseWaitResource.Read(ctx, req, resp, &data)
+}
+
+func (r *NamespacesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *NamespacesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data NamespacesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || !data.WaitOnDestroy.ValueBool() {
+		return
+	}
+
+	r.resourceType = "namespaces"
+	r.BaseWaitResource.WaitForDelete(ctx, resp, &data)
+}
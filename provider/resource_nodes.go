@@ -30,7 +30,7 @@ func (r *NodesResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	resp.Schema = GetCommonSchema(ResourceConfig{
 		TypeName:         "nodes",
 		Description:      "Waits for Kubernetes nodes to meet specified conditions before allowing dependent resources to proceed.",
-		ForDescription:   "Condition to wait for (e.g., 'condition=Ready', 'status=Running')",
+		ForDescription:   "Condition to wait for (e.g., 'condition=Ready', 'label=pool=gpu', 'notaint=node.cilium.io/agent-not-ready', 'schedulable=true', 'kubelet=1.29', 'allocatable=nvidia.com/gpu>=1')",
 		IncludeNamespace: false, // Nodes are cluster-scoped
 	})
 }
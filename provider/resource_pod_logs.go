@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PodLogsResource{}
+
+func NewPodLogsResource() resource.Resource {
+	return &PodLogsResource{}
+}
+
+// PodLogsResource defines the resource implementation.
+type PodLogsResource struct {
+	BaseWaitResource
+}
+
+// PodLogsResourceModel describes the resource data model.
+type PodLogsResourceModel struct {
+	// Pod selection and patterns
+	Namespace    types.String `tfsdk:"namespace"`
+	Name         types.String `tfsdk:"name"`
+	Labels       types.String `tfsdk:"labels"`
+	Container    types.String `tfsdk:"container"`
+	Pattern      types.String `tfsdk:"pattern"`
+	ErrorPattern types.String `tfsdk:"error_pattern"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+	Pod          types.String `tfsdk:"pod"`
+	MatchedLine  types.String `tfsdk:"matched_line"`
+}
+
+func (r *PodLogsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pod_logs"
+	r.resourceType = "podlogs"
+}
+
+func (r *PodLogsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["namespace"] = schema.StringAttribute{
+		MarkdownDescription: "Namespace of the pods. Defaults to 'default'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("default"),
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the pod whose logs are followed. Either `name` or `labels` must be set.",
+		Optional:            true,
+	}
+	attributes["labels"] = schema.StringAttribute{
+		MarkdownDescription: "Label selector of the pods whose logs are followed (e.g., 'app=legacy'). The first line matching in any of the pods completes the wait.",
+		Optional:            true,
+	}
+	attributes["container"] = schema.StringAttribute{
+		MarkdownDescription: "Container whose logs are followed. Defaults to the `kubectl.kubernetes.io/default-container` annotation or the first container.",
+		Optional:            true,
+	}
+	attributes["pattern"] = schema.StringAttribute{
+		MarkdownDescription: "Regular expression a log line must match (e.g., 'Server started').",
+		Required:            true,
+	}
+	attributes["error_pattern"] = schema.StringAttribute{
+		MarkdownDescription: "Regular expression of log lines that fail the wait (e.g., 'FATAL|panic:').",
+		Optional:            true,
+	}
+	attributes["pod"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the pod that logged the matching line",
+		Computed:            true,
+	}
+	attributes["matched_line"] = schema.StringAttribute{
+		MarkdownDescription: "The log line that matched `pattern`",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Follows the logs of a pod, or of the pods matching a label selector, until a line matches a pattern. Fails when a line matches the error pattern or the container terminates first.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *PodLogsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PodLogsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (data.Name.ValueString() == "") == (data.Labels.ValueString() == "") {
+		resp.Diagnostics.AddError(
+			"Invalid pod selection",
+			"Exactly one of 'name' or 'labels' must be set.",
+		)
+		return
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	podLogsChecker := &kubernetes.PodLogsChecker{
+		Client: client,
+		Config: &kubernetes.PodLogsConfig{
+			Namespace:     r.getNamespaceValue(data.Namespace.ValueString()),
+			Name:          data.Name.ValueString(),
+			Labels:        data.Labels.ValueString(),
+			Container:     data.Container.ValueString(),
+			Pattern:       data.Pattern.ValueString(),
+			ErrorPattern:  data.ErrorPattern.ValueString(),
+			Timeout:       time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval: time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	result, err := podLogsChecker.WaitForPodLogs(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.Pod = types.StringValue(result.Pod)
+	data.MatchedLine = types.StringValue(result.MatchedLine)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PodLogsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PodLogsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PodLogsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *PodLogsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -19,7 +20,7 @@ type PodsResource struct {
 }
 
 // PodsResourceModel describes the resource data model.
-type PodsResourceModel = GenericWaitResourceModel
+type PodsResourceModel = PodWaitResourceModel
 
 func (r *PodsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_pods"
@@ -30,9 +31,19 @@ func (r *PodsResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 	resp.Schema = GetCommonSchema(ResourceConfig{
 		TypeName:         "pods",
 		Description:      "Waits for Kubernetes pods to meet specified conditions before allowing dependent resources to proceed.",
-		ForDescription:   "Condition to wait for (e.g., 'condition=Ready', 'condition=PodScheduled')",
+		ForDescription:   "Condition to wait for (e.g., 'condition=Ready', 'phase=Running', 'container=istio-proxy', 'started=app', 'init=completed')",
 		IncludeNamespace: true,
+		IncludeImages:    true,
 	})
+
+	resp.Schema.Attributes["max_restarts"] = schema.Int64Attribute{
+		MarkdownDescription: "Fail the wait if any container of a matching pod restarts more than this many times while waiting. Restarts before the wait started are ignored. Cannot be combined with `current`, `healthy`, `jsonpath` or `delete` conditions.",
+		Optional:            true,
+	}
+	resp.Schema.Attributes["children_of"] = schema.StringAttribute{
+		MarkdownDescription: "Owner whose current pods to wait for instead of duplicating its label selector, as 'kind/name' (e.g., 'deployment/my-app', 'statefulset/db', 'daemonset/agent', 'job/migrate') or 'resource.group/name' for other owners (e.g., 'rollouts.argoproj.io/my-app'). Only pods of the current ReplicaSet or revision are checked. Cannot be combined with `current`, `healthy`, `jsonpath` or `delete` conditions.",
+		Optional:            true,
+	}
 }
 
 func (r *PodsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
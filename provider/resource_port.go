@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"nuxij/kubewait/internal/kubernetes"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PortResource{}
+
+func NewPortResource() resource.Resource {
+	return &PortResource{}
+}
+
+// PortResource defines the resource implementation.
+type PortResource struct {
+	BaseWaitResource
+}
+
+// PortResourceModel describes the resource data model.
+type PortResourceModel struct {
+	// Port-forward target and probe
+	Namespace   types.String `tfsdk:"namespace"`
+	Pod         types.String `tfsdk:"pod"`
+	Service     types.String `tfsdk:"service"`
+	Port        types.String `tfsdk:"port"`
+	Check       types.String `tfsdk:"check"`
+	GRPCService types.String `tfsdk:"grpc_service"`
+	HTTPPath    types.String `tfsdk:"http_path"`
+	HTTPStatus  types.Int64  `tfsdk:"http_status"`
+
+	// Common wait attributes
+	Timeout       types.Int64 `tfsdk:"timeout"`
+	CheckInterval types.Int64 `tfsdk:"check_interval"`
+	CheckOnce     types.Bool  `tfsdk:"check_once"`
+
+	// Authentication config
+	KubeConfigType types.String `tfsdk:"kube_config_type"`
+	KubeConfig     types.String `tfsdk:"kube_config"`
+	Context        types.String `tfsdk:"context"`
+
+	// Computed attributes
+	ID           types.String `tfsdk:"id"`
+	ConditionMet types.Bool   `tfsdk:"condition_met"`
+	LastChecked  types.String `tfsdk:"last_checked"`
+	Message      types.String `tfsdk:"message"`
+	ForwardedPod types.String `tfsdk:"forwarded_pod"`
+}
+
+func (r *PortResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_port"
+	r.resourceType = "port"
+}
+
+func (r *PortResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := GetBaseAttributes()
+
+	attributes["namespace"] = schema.StringAttribute{
+		MarkdownDescription: "Namespace of the pod or service. Defaults to 'default'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("default"),
+	}
+	attributes["pod"] = schema.StringAttribute{
+		MarkdownDescription: "Pod to forward the port of. Either `pod` or `service` must be set.",
+		Optional:            true,
+	}
+	attributes["service"] = schema.StringAttribute{
+		MarkdownDescription: "Service whose first ready pod the port is forwarded to, using the target port of the service port.",
+		Optional:            true,
+	}
+	attributes["port"] = schema.StringAttribute{
+		MarkdownDescription: "Port name or number of the pod, or of the service. Can be omitted for services with a single port.",
+		Optional:            true,
+	}
+	attributes["check"] = schema.StringAttribute{
+		MarkdownDescription: "Probe to run over the port-forward: 'tcp' (the connection is accepted), 'grpc' (`grpc.health.v1.Health/Check` returns SERVING) or 'http' (a GET request returns `http_status`). Defaults to 'tcp'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("tcp"),
+		Validators: []validator.String{
+			stringvalidator.OneOf("tcp", "grpc", "http"),
+		},
+	}
+	attributes["grpc_service"] = schema.StringAttribute{
+		MarkdownDescription: "Service name sent in the gRPC health check. Defaults to the overall health of the server.",
+		Optional:            true,
+	}
+	attributes["http_path"] = schema.StringAttribute{
+		MarkdownDescription: "Path of the HTTP request. Defaults to '/'.",
+		Optional:            true,
+		Computed:            true,
+		Default:             stringdefault.StaticString("/"),
+	}
+	attributes["http_status"] = schema.Int64Attribute{
+		MarkdownDescription: "Expected status code of the HTTP response. Defaults to 200.",
+		Optional:            true,
+		Computed:            true,
+		Default:             int64default.StaticInt64(200),
+	}
+	attributes["forwarded_pod"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the pod the successful probe was forwarded to",
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Opens a port-forward to a pod, or to a ready pod behind a service, and waits until a TCP, gRPC health or HTTP probe over the tunnel succeeds.",
+		Attributes:          attributes,
+	}
+}
+
+func (r *PortResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PortResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if (data.Pod.ValueString() == "") == (data.Service.ValueString() == "") {
+		resp.Diagnostics.AddError(
+			"Invalid port-forward target",
+			"Exactly one of 'pod' or 'service' must be set.",
+		)
+		return
+	}
+	if data.Pod.ValueString() != "" && data.Port.ValueString() == "" {
+		resp.Diagnostics.AddError(
+			"Missing port",
+			"The 'port' field is required when forwarding to a pod.",
+		)
+		return
+	}
+
+	client, err := r.newClient(ctx, data.KubeConfigType.ValueString(), data.KubeConfig.ValueString(), data.Context.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Kubernetes client",
+			err.Error(),
+		)
+		return
+	}
+
+	portChecker := &kubernetes.PortChecker{
+		Client: client,
+		Config: &kubernetes.PortConfig{
+			Namespace:     r.getNamespaceValue(data.Namespace.ValueString()),
+			Pod:           data.Pod.ValueString(),
+			Service:       data.Service.ValueString(),
+			Port:          data.Port.ValueString(),
+			Check:         data.Check.ValueString(),
+			GRPCService:   data.GRPCService.ValueString(),
+			HTTPPath:      data.HTTPPath.ValueString(),
+			HTTPStatus:    int(data.HTTPStatus.ValueInt64()),
+			Timeout:       time.Duration(data.Timeout.ValueInt64()) * time.Second,
+			CheckInterval: time.Duration(data.CheckInterval.ValueInt64()) * time.Second,
+		},
+	}
+
+	result, err := portChecker.WaitForPort(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Wait operation failed",
+			err.Error(),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s-wait-%d", r.resourceType, time.Now().Unix()))
+	data.ConditionMet = types.BoolValue(result.ConditionMet)
+	data.LastChecked = types.StringValue(result.LastChecked.Format(time.RFC3339))
+	data.Message = types.StringValue(result.Message)
+	data.ForwardedPod = types.StringValue(result.Pod)
+	if data.KubeConfigType.ValueString() == "" {
+		data.KubeConfigType = types.StringValue("provider")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PortResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PortResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PortResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *PortResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
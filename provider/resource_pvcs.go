@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PVCsResource{}
+
+func NewPVCsResource() resource.Resource {
+	return &PVCsResource{}
+}
+
+// PVCsResource defines the resource implementation.
+type PVCsResource struct {
+	BaseWaitResource
+}
+
+// PVCsResourceModel describes the resource data model.
+type PVCsResourceModel = GenericWaitResourceModel
+
+func (r *PVCsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pvcs"
+	r.resourceType = "persistentvolumeclaims"
+}
+
+func (r *PVCsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = GetCommonSchema(ResourceConfig{
+		TypeName:         "persistentvolumeclaims",
+		Description:      "Waits for Kubernetes persistentvolumeclaims to meet specified conditions before allowing dependent resources to proceed.",
+		ForDescription:   "Condition to wait for (e.g., 'phase=Bound', 'resize=complete', 'condition=FileSystemResizePending')",
+		IncludeNamespace: true,
+	})
+}
+
+func (r *PVCsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PVCsResourceModel
+	// Set the resource type before calling the base method
+	r.resourceType = "persistentvolumeclaims"
+	r.BaseWaitResource.Create(ctx, req, resp, &data)
+}
+
+func (r *PVCsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PVCsResourceModel
+	// Set the resource type before calling the base method
+	r.resourceType = "persistentvolumeclaims"
+	r.BaseWaitResource.Read(ctx, req, resp, &data)
+}
+
+func (r *PVCsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *PVCsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PVsResource{}
+
+func NewPVsResource() resource.Resource {
+	return &PVsResource{}
+}
+
+// PVsResource defines the resource implementation.
+type PVsResource struct {
+	BaseWaitResource
+}
+
+// PVsResourceModel describes the resource data model.
+type PVsResourceModel = ClusterScopedWaitResourceModel
+
+func (r *PVsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pvs"
+	r.resourceType = "persistentvolumes"
+}
+
+func (r *PVsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = GetCommonSchema(ResourceConfig{
+		TypeName:         "persistentvolumes",
+		Description:      "Waits for Kubernetes persistentvolumes to meet specified conditions before allowing dependent resources to proceed.",
+		ForDescription:   "Condition to wait for (e.g., 'phase=Bound', 'phase=Available')",
+		IncludeNamespace: false, // PersistentVolumes are cluster-scoped
+	})
+}
+
+func (r *PVsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PVsResourceModel
+	// Set the resource type before calling the base method
+	r.resourceType = "persistentvolumes"
+	r.BaseWaitResource.Create(ctx, req, resp, &data)
+}
+
+func (r *PVsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PVsResourceModel
+	// Set the resource type before calling the base method
+	r.resourceType = "persistentvolumes"
+	r.BaseWaitResource.Read(ctx, req, resp, &data)
+}
+
+func (r *PVsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	r.BaseWaitResource.Update(ctx, req, resp)
+}
+
+func (r *PVsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	r.BaseWaitResource.Delete(ctx, req, resp)
+}
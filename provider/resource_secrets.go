@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecretsResource{}
+
+func NewSecretsResource() resource.Resource {
+	return &SecretsResource{DataWaitResource{kind: "secret"}}
+}
+
+// SecretsResource defines the resource implementation.
+type SecretsResource struct {
+	DataWaitResource
+}
+
+// SecretsResourceModel describes the resource data model.
+type SecretsResourceModel = DataWaitResourceModel
+
+func (r *SecretsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_secrets"
+	r.resourceType = "secrets"
+}
+
+func (r *SecretsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = GetDataSchema("Secret", "Waits for a Secret written by a controller (cert-manager, external-secrets, cloud operators) to exist with the required keys, and exposes selected values.")
+}
@@ -19,7 +19,7 @@ type ServicesResource struct {
 }
 
 // ServicesResourceModel describes the resource data model.
-type ServicesResourceModel = GenericWaitResourceModel
+type ServicesResourceModel = AddressWaitResourceModel
 
 func (r *ServicesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_services"
@@ -30,8 +30,9 @@ func (r *ServicesResource) Schema(ctx context.Context, req resource.SchemaReques
 	resp.Schema = GetCommonSchema(ResourceConfig{
 		TypeName:         "services",
 		Description:      "Waits for Kubernetes services to meet specified conditions before allowing dependent resources to proceed.",
-		ForDescription:   "Condition to wait for (e.g., 'jsonpath={.status.loadBalancer.ingress[0].ip}', 'jsonpath={.spec.clusterIP}')",
+		ForDescription:   "Condition to wait for (e.g., 'endpoints=2', 'loadbalancer=ready', 'externalname=resolved', 'exists=true')",
 		IncludeNamespace: true,
+		IncludeAddresses: true,
 	})
 }
 
@@ -19,7 +19,7 @@ type StatefulSetsResource struct {
 }
 
 // StatefulSetsResourceModel describes the resource data model.
-type StatefulSetsResourceModel = GenericWaitResourceModel
+type StatefulSetsResourceModel = WorkloadWaitResourceModel
 
 func (r *StatefulSetsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_statefulsets"
@@ -32,6 +32,7 @@ func (r *StatefulSetsResource) Schema(ctx context.Context, req resource.SchemaRe
 		Description:      "Waits for Kubernetes statefulsets to meet specified conditions before allowing dependent resources to proceed.",
 		ForDescription:   "Condition to wait for (e.g., 'jsonpath={.status.readyReplicas}=3', 'jsonpath={.status.replicas}={.status.readyReplicas}')",
 		IncludeNamespace: true,
+		IncludeImages:    true,
 	})
 }
 